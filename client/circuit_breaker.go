@@ -0,0 +1,128 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/go-hclog"
+)
+
+const defaultCircuitBreakerCooldown = 60
+
+// circuitOpenError is returned instead of calling AWS while the breaker of a service+region is open
+type circuitOpenError struct {
+	key string
+}
+
+func (e circuitOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker open for %s, skipping call", e.key)
+}
+
+func isCircuitOpenError(err error) bool {
+	var ce circuitOpenError
+	return errors.As(err, &ce)
+}
+
+type circuitState struct {
+	failures  int
+	openUntil time.Time
+}
+
+// circuitBreaker short-circuits calls to a service+region after threshold consecutive
+// server or throttling failures, until cooldown passes.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	logger    hclog.Logger
+	now       func() time.Time
+
+	mu     sync.Mutex
+	states map[string]*circuitState
+}
+
+// newCircuitBreaker returns nil when the breaker is disabled in the config
+func newCircuitBreaker(logger hclog.Logger, cfg *CircuitBreakerConfig) *circuitBreaker {
+	if cfg == nil || cfg.FailureThreshold <= 0 {
+		return nil
+	}
+	cooldown := cfg.Cooldown
+	if cooldown <= 0 {
+		cooldown = defaultCircuitBreakerCooldown
+	}
+	return &circuitBreaker{
+		threshold: cfg.FailureThreshold,
+		cooldown:  time.Second * time.Duration(cooldown),
+		logger:    logger,
+		now:       time.Now,
+		states:    make(map[string]*circuitState),
+	}
+}
+
+// allow reports whether a call for key may go through. Once the cooldown has passed the breaker closes again.
+func (b *circuitBreaker) allow(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.states[key]
+	if !ok || s.openUntil.IsZero() {
+		return true
+	}
+	if b.now().Before(s.openUntil) {
+		return false
+	}
+	s.failures = 0
+	s.openUntil = time.Time{}
+	return true
+}
+
+// record tracks the outcome of a call for key, opening the breaker after threshold consecutive failures
+func (b *circuitBreaker) record(key string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s, ok := b.states[key]
+	if !ok {
+		s = &circuitState{}
+		b.states[key] = s
+	}
+	if !isCircuitBreakerFailure(err) {
+		s.failures = 0
+		return
+	}
+	s.failures++
+	if s.failures < b.threshold || !s.openUntil.IsZero() {
+		return
+	}
+	s.openUntil = b.now().Add(b.cooldown)
+	b.logger.Warn("circuit breaker opened, skipping calls until cooldown passes", "service_region", key, "failures", s.failures, "cooldown", b.cooldown.String(), "err", err)
+}
+
+// addMiddleware registers the breaker on an AWS SDK stack. It runs after the service metadata is registered
+// and before retries, so a call that exhausted its retries counts as a single failure.
+func (b *circuitBreaker) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("CircuitBreaker", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		key := awsmiddleware.GetServiceID(ctx) + "/" + awsmiddleware.GetRegion(ctx)
+		if !b.allow(key) {
+			return middleware.InitializeOutput{}, middleware.Metadata{}, circuitOpenError{key: key}
+		}
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		b.record(key, err)
+		return out, metadata, err
+	}), middleware.After)
+}
+
+func isCircuitBreakerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool() {
+		return true
+	}
+	var re *smithyhttp.ResponseError
+	return errors.As(err, &re) && re.HTTPStatusCode() >= 500
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func serverError() error {
+	return &smithyhttp.ResponseError{
+		Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusInternalServerError}},
+		Err:      errors.New("internal error"),
+	}
+}
+
+func TestCircuitBreakerDisabledByDefault(t *testing.T) {
+	assert.Nil(t, newCircuitBreaker(hclog.NewNullLogger(), nil))
+	assert.Nil(t, newCircuitBreaker(hclog.NewNullLogger(), &CircuitBreakerConfig{}))
+}
+
+func TestCircuitBreakerOpensAndCloses(t *testing.T) {
+	now := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+	b := newCircuitBreaker(hclog.NewNullLogger(), &CircuitBreakerConfig{FailureThreshold: 3, Cooldown: 30})
+	b.now = func() time.Time { return now }
+	key := "Firehose/us-east-1"
+
+	// failures below the threshold keep the breaker closed, and a success resets the count
+	b.record(key, serverError())
+	b.record(key, serverError())
+	b.record(key, nil)
+	b.record(key, serverError())
+	b.record(key, serverError())
+	assert.True(t, b.allow(key))
+
+	// non server errors don't count
+	b.record(key, errors.New("validation error"))
+	b.record(key, serverError())
+	b.record(key, serverError())
+	assert.True(t, b.allow(key))
+
+	b.record(key, serverError())
+	assert.False(t, b.allow(key))
+	// other service+region partitions are unaffected
+	assert.True(t, b.allow("Firehose/eu-west-1"))
+
+	now = now.Add(29 * time.Second)
+	assert.False(t, b.allow(key))
+
+	now = now.Add(time.Second)
+	assert.True(t, b.allow(key))
+	b.record(key, serverError())
+	assert.True(t, b.allow(key))
+}
+
+func TestCircuitOpenErrorIgnored(t *testing.T) {
+	assert.True(t, IgnoreCommonErrors(circuitOpenError{key: "Firehose/us-east-1"}))
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigatewayv2"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
@@ -21,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
@@ -31,6 +33,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	"github.com/aws/aws-sdk-go-v2/service/configservice"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
 	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
 	"github.com/aws/aws-sdk-go-v2/service/dax"
 	"github.com/aws/aws-sdk-go-v2/service/directconnect"
@@ -38,6 +41,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
@@ -60,6 +64,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lightsail"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
 	"github.com/aws/aws-sdk-go-v2/service/qldb"
@@ -71,8 +76,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/aws/aws-sdk-go-v2/service/sesv2"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -97,6 +104,9 @@ type Client struct {
 	logLevel        *string
 	maxRetries      int
 	maxBackoff      int
+	warnUntagged    bool
+	metrics         MetricsSink
+	throttleRetry   throttleRetryOptions
 	ServicesManager ServicesManager
 	logger          hclog.Logger
 	// this is set by table clientList
@@ -126,6 +136,7 @@ type AssumeRoleAPIClient interface {
 
 type Services struct {
 	ACM                    ACMClient
+	ACMPCA                 ACMPCAClient
 	Analyzer               AnalyzerClient
 	Apigateway             ApigatewayClient
 	Apigatewayv2           Apigatewayv2Client
@@ -134,6 +145,7 @@ type Services struct {
 	Athena                 AthenaClient
 	Autoscaling            AutoscalingClient
 	Backup                 BackupClient
+	Batch                  BatchClient
 	Cloudformation         CloudFormationClient
 	Cloudfront             CloudfrontClient
 	Cloudtrail             CloudtrailClient
@@ -144,12 +156,14 @@ type Services struct {
 	CognitoIdentityPools   CognitoIdentityPoolsClient
 	CognitoUserPools       CognitoUserPoolsClient
 	ConfigService          ConfigServiceClient
+	Connect                ConnectClient
 	DAX                    DAXClient
 	Directconnect          DirectconnectClient
 	DMS                    DatabasemigrationserviceClient
 	DynamoDB               DynamoDBClient
 	EC2                    Ec2Client
 	ECR                    EcrClient
+	ECRPublic              EcrPublicClient
 	ECS                    EcsClient
 	EFS                    EfsClient
 	Eks                    EksClient
@@ -172,6 +186,7 @@ type Services struct {
 	KMS                    KmsClient
 	Lambda                 LambdaClient
 	Lightsail              LightsailClient
+	Macie2                 Macie2Client
 	MQ                     MQClient
 	Organizations          OrganizationsClient
 	QLDB                   QLDBClient
@@ -184,8 +199,10 @@ type Services struct {
 	S3Control              S3ControlClient
 	S3Manager              S3ManagerClient
 	SageMaker              SageMakerClient
+	SavingsPlans           SavingsPlansClient
 	SecretsManager         SecretsManagerClient
 	SES                    SESClient
+	SFN                    SFNClient
 	Shield                 ShieldClient
 	SNS                    SnsClient
 	SQS                    SQSClient
@@ -203,6 +220,7 @@ type ServicesPartitionAccountRegionMap map[string]map[string]map[string]*Service
 type ServicesManager struct {
 	services         ServicesPartitionAccountRegionMap
 	wafScopeServices map[string]map[string]*Services
+	enabledRegions   *enabledRegionsCache
 }
 
 const (
@@ -257,6 +275,12 @@ func (s *ServicesManager) InitServicesForPartitionAccountAndRegion(partition, ac
 	s.services[partition][accountId][region] = &services
 }
 
+// SetEnabledRegions records regions already known to be enabled for the account, so
+// Client.EnabledRegions doesn't have to query them again.
+func (s *ServicesManager) SetEnabledRegions(partition, accountId string, regions []string) {
+	s.enabledRegions.add(partition, accountId, regions)
+}
+
 func (s *ServicesManager) InitServicesForPartitionAccountAndScope(partition, accountId string, services Services) {
 	if s.wafScopeServices == nil {
 		s.wafScopeServices = make(map[string]map[string]*Services)
@@ -277,12 +301,18 @@ func (s3Manager S3Manager) GetBucketRegion(ctx context.Context, bucket string, o
 	return manager.GetBucketRegion(ctx, s3Manager.s3Client, bucket, optFns...)
 }
 
+func newServicesManager() ServicesManager {
+	return ServicesManager{
+		services:         ServicesPartitionAccountRegionMap{},
+		wafScopeServices: make(map[string]map[string]*Services),
+		enabledRegions:   newEnabledRegionsCache(),
+	}
+}
+
 func NewAwsClient(logger hclog.Logger) Client {
 	return Client{
-		ServicesManager: ServicesManager{
-			services: ServicesPartitionAccountRegionMap{},
-		},
-		logger: logger,
+		ServicesManager: newServicesManager(),
+		logger:          logger,
 	}
 }
 
@@ -338,6 +368,9 @@ func (c *Client) withPartitionAccountIDAndRegion(partition, accountID, region st
 		logLevel:             c.logLevel,
 		maxRetries:           c.maxRetries,
 		maxBackoff:           c.maxBackoff,
+		warnUntagged:         c.warnUntagged,
+		metrics:              c.metrics,
+		throttleRetry:        c.throttleRetry,
 		ServicesManager:      c.ServicesManager,
 		logger:               c.logger.With("account_id", obfuscateAccountId(accountID), "Region", region),
 		AccountID:            accountID,
@@ -353,6 +386,9 @@ func (c *Client) withPartitionAccountIDRegionAndNamespace(partition, accountID,
 		logLevel:             c.logLevel,
 		maxRetries:           c.maxRetries,
 		maxBackoff:           c.maxBackoff,
+		warnUntagged:         c.warnUntagged,
+		metrics:              c.metrics,
+		throttleRetry:        c.throttleRetry,
 		ServicesManager:      c.ServicesManager,
 		logger:               c.logger.With("account_id", obfuscateAccountId(accountID), "Region", region, "AutoscalingNamespace", namespace),
 		AccountID:            accountID,
@@ -368,6 +404,9 @@ func (c *Client) withPartitionAccountIDRegionAndScope(partition, accountID, regi
 		logLevel:             c.logLevel,
 		maxRetries:           c.maxRetries,
 		maxBackoff:           c.maxBackoff,
+		warnUntagged:         c.warnUntagged,
+		metrics:              c.metrics,
+		throttleRetry:        c.throttleRetry,
 		ServicesManager:      c.ServicesManager,
 		logger:               c.logger.With("account_id", obfuscateAccountId(accountID), "Region", region, "Scope", scope),
 		AccountID:            accountID,
@@ -421,6 +460,11 @@ func getAccountId(ctx context.Context, awsCfg aws.Config) (*sts.GetCallerIdentit
 	return svc.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 }
 
+// newAssumeRoleClient creates the STS client used to assume a role with the credentials of cfg
+var newAssumeRoleClient = func(cfg aws.Config) AssumeRoleAPIClient {
+	return sts.NewFromConfig(cfg)
+}
+
 func configureAwsClient(ctx context.Context, logger hclog.Logger, awsConfig *Config, account Account, stsClient AssumeRoleAPIClient) (aws.Config, error) {
 	var err error
 	var awsCfg aws.Config
@@ -445,22 +489,24 @@ func configureAwsClient(ctx context.Context, logger hclog.Logger, awsConfig *Con
 		return awsCfg, err
 	}
 
-	if account.RoleARN != "" {
-		opts := make([]func(*stscreds.AssumeRoleOptions), 0, 1)
-		if account.ExternalID != "" {
+	for i, hop := range account.roleChain() {
+		hop := hop
+		opts := make([]func(*stscreds.AssumeRoleOptions), 0, 2)
+		if hop.ExternalID != "" {
 			opts = append(opts, func(opts *stscreds.AssumeRoleOptions) {
-				opts.ExternalID = &account.ExternalID
+				opts.ExternalID = &hop.ExternalID
 			})
 		}
-		if account.RoleSessionName != "" {
+		if hop.RoleSessionName != "" {
 			opts = append(opts, func(opts *stscreds.AssumeRoleOptions) {
-				opts.RoleSessionName = account.RoleSessionName
+				opts.RoleSessionName = hop.RoleSessionName
 			})
 		}
-		if stsClient == nil {
-			stsClient = sts.NewFromConfig(awsCfg)
+		// every hop after the first assumes its role with the credentials of the previous hop
+		if i > 0 || stsClient == nil {
+			stsClient = newAssumeRoleClient(awsCfg)
 		}
-		provider := stscreds.NewAssumeRoleProvider(stsClient, account.RoleARN, opts...)
+		provider := stscreds.NewAssumeRoleProvider(stsClient, hop.RoleARN, opts...)
 
 		awsCfg.Credentials = aws.NewCredentialsCache(provider)
 	}
@@ -506,6 +552,14 @@ func Configure(logger hclog.Logger, providerConfig interface{}) (schema.ClientMe
 	awsConfig := providerConfig.(*Config)
 	client := NewAwsClient(logger)
 	client.GlobalRegion = awsConfig.GlobalRegion
+	client.warnUntagged = awsConfig.WarnUntagged
+	metrics, err := newStatsdSink(awsConfig.Metrics)
+	if err != nil {
+		return nil, diags.Add(diag.FromError(err, diag.USER))
+	}
+	client.metrics = metrics
+	client.throttleRetry = newThrottleRetryOptions(awsConfig.ThrottleRetry)
+	breaker := newCircuitBreaker(logger, awsConfig.CircuitBreaker)
 	var adminAccountSts AssumeRoleAPIClient
 	if awsConfig.Organization != nil && len(awsConfig.Accounts) > 0 {
 		return nil, diags.Add(diag.FromError(errors.New("specifying accounts via both the Accounts and Org properties is not supported. If you want to do both, you should use multiple provider blocks"), diag.USER))
@@ -579,6 +633,13 @@ func Configure(logger hclog.Logger, providerConfig interface{}) (schema.ClientMe
 			return nil, diags.Add(diag.FromError(err, diag.ACCESS))
 		}
 
+		if breaker != nil {
+			awsCfg.APIOptions = append(awsCfg.APIOptions, breaker.addMiddleware)
+		}
+		if limiter := newRateLimiter(awsConfig.RateLimits); limiter != nil {
+			awsCfg.APIOptions = append(awsCfg.APIOptions, limiter.addMiddleware)
+		}
+
 		// This is a work-around to skip disabled regions
 		// https://github.com/aws/aws-sdk-go-v2/issues/1068
 		res, err := ec2.NewFromConfig(awsCfg).DescribeRegions(ctx,
@@ -618,6 +679,7 @@ func Configure(logger hclog.Logger, providerConfig interface{}) (schema.ClientMe
 		for _, region := range account.Regions {
 			client.ServicesManager.InitServicesForPartitionAccountAndRegion(iamArn.Partition, *output.Account, region, initServices(region, awsCfg))
 		}
+		client.ServicesManager.SetEnabledRegions(iamArn.Partition, *output.Account, account.Regions)
 		client.ServicesManager.InitServicesForPartitionAccountAndScope(iamArn.Partition, *output.Account, initServices(cloudfrontScopeRegion, awsCfg))
 	}
 	if len(client.ServicesManager.services) == 0 {
@@ -631,6 +693,7 @@ func initServices(region string, c aws.Config) Services {
 	awsCfg.Region = region
 	return Services{
 		ACM:                    acm.NewFromConfig(awsCfg),
+		ACMPCA:                 acmpca.NewFromConfig(awsCfg),
 		Analyzer:               accessanalyzer.NewFromConfig(awsCfg),
 		Apigateway:             apigateway.NewFromConfig(awsCfg),
 		Apigatewayv2:           apigatewayv2.NewFromConfig(awsCfg),
@@ -639,6 +702,7 @@ func initServices(region string, c aws.Config) Services {
 		Athena:                 athena.NewFromConfig(awsCfg),
 		Autoscaling:            autoscaling.NewFromConfig(awsCfg),
 		Backup:                 backup.NewFromConfig(awsCfg),
+		Batch:                  batch.NewFromConfig(awsCfg),
 		Cloudformation:         cloudformation.NewFromConfig(awsCfg),
 		Cloudfront:             cloudfront.NewFromConfig(awsCfg),
 		Cloudtrail:             cloudtrail.NewFromConfig(awsCfg),
@@ -649,12 +713,14 @@ func initServices(region string, c aws.Config) Services {
 		CognitoIdentityPools:   cognitoidentity.NewFromConfig(awsCfg),
 		CognitoUserPools:       cognitoidentityprovider.NewFromConfig(awsCfg),
 		ConfigService:          configservice.NewFromConfig(awsCfg),
+		Connect:                connect.NewFromConfig(awsCfg),
 		DAX:                    dax.NewFromConfig(awsCfg),
 		Directconnect:          directconnect.NewFromConfig(awsCfg),
 		DMS:                    databasemigrationservice.NewFromConfig(awsCfg),
 		DynamoDB:               dynamodb.NewFromConfig(awsCfg),
 		EC2:                    ec2.NewFromConfig(awsCfg),
 		ECR:                    ecr.NewFromConfig(awsCfg),
+		ECRPublic:              ecrpublic.NewFromConfig(awsCfg),
 		ECS:                    ecs.NewFromConfig(awsCfg),
 		EFS:                    efs.NewFromConfig(awsCfg),
 		Eks:                    eks.NewFromConfig(awsCfg),
@@ -677,6 +743,7 @@ func initServices(region string, c aws.Config) Services {
 		KMS:                    kms.NewFromConfig(awsCfg),
 		Lambda:                 lambda.NewFromConfig(awsCfg),
 		Lightsail:              lightsail.NewFromConfig(awsCfg),
+		Macie2:                 macie2.NewFromConfig(awsCfg),
 		MQ:                     mq.NewFromConfig(awsCfg),
 		Organizations:          organizations.NewFromConfig(awsCfg),
 		QLDB:                   qldb.NewFromConfig(awsCfg),
@@ -689,8 +756,10 @@ func initServices(region string, c aws.Config) Services {
 		S3Control:              s3control.NewFromConfig(awsCfg),
 		S3Manager:              newS3ManagerFromConfig(awsCfg),
 		SageMaker:              sagemaker.NewFromConfig(awsCfg),
+		SavingsPlans:           savingsplans.NewFromConfig(awsCfg),
 		SecretsManager:         secretsmanager.NewFromConfig(awsCfg),
 		SES:                    sesv2.NewFromConfig(awsCfg),
+		SFN:                    sfn.NewFromConfig(awsCfg),
 		Shield:                 shield.NewFromConfig(awsCfg),
 		SNS:                    sns.NewFromConfig(awsCfg),
 		SQS:                    sqs.NewFromConfig(awsCfg),
@@ -313,3 +313,50 @@ func Test_Configure(t *testing.T) {
 		}
 	}
 }
+
+func Test_configureAwsClient_RoleChain(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	// assumeRole returns credentials keyed by the assumed role, recording which credentials assumed it
+	assumeRole := func(caller string) AssumeRoleAPIClient {
+		return mockAssumeRole(func(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+			calls = append(calls, caller+" -> "+aws.ToString(params.RoleArn)+" "+aws.ToString(params.ExternalId)+" "+aws.ToString(params.RoleSessionName))
+			return &sts.AssumeRoleOutput{
+				Credentials: &stsTypes.Credentials{
+					AccessKeyId:     params.RoleArn,
+					Expiration:      aws.Time(time.Now().Add(time.Hour)),
+					SecretAccessKey: aws.String("<AssumedRoleKeySecret>"),
+					SessionToken:    aws.String("<AssumedRoleSessionToken>"),
+				},
+			}, nil
+		})
+	}
+	defer func(f func(aws.Config) AssumeRoleAPIClient) { newAssumeRoleClient = f }(newAssumeRoleClient)
+	newAssumeRoleClient = func(cfg aws.Config) AssumeRoleAPIClient {
+		creds, err := cfg.Credentials.Retrieve(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return assumeRole(creds.AccessKeyID)
+	}
+
+	account := Account{
+		RoleChain: []RoleHop{
+			{RoleARN: "arn:aws:iam::111111111111:role/jump", ExternalID: "jump-id", RoleSessionName: "jump-session"},
+			{RoleARN: "arn:aws:iam::222222222222:role/hop", RoleSessionName: "hop-session"},
+		},
+		RoleARN:         "arn:aws:iam::333333333333:role/workload",
+		ExternalID:      "workload-id",
+		RoleSessionName: "workload-session",
+	}
+	awsCfg, err := configureAwsClient(ctx, hclog.NewNullLogger(), &Config{}, account, assumeRole("<base>"))
+	assert.NoError(t, err)
+	creds, err := awsCfg.Credentials.Retrieve(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "arn:aws:iam::333333333333:role/workload", creds.AccessKeyID)
+	assert.Equal(t, []string{
+		"<base> -> arn:aws:iam::111111111111:role/jump jump-id jump-session",
+		"arn:aws:iam::111111111111:role/jump -> arn:aws:iam::222222222222:role/hop  hop-session",
+		"arn:aws:iam::222222222222:role/hop -> arn:aws:iam::333333333333:role/workload workload-id workload-session",
+	}, calls)
+}
@@ -3,16 +3,33 @@ package client
 type Account struct {
 	ID              string `yaml:"id"`
 	AccountID       string
-	AccountName     string   `yaml:"account_name,omitempty"`
-	LocalProfile    string   `yaml:"local_profile,omitempty"`
-	RoleARN         string   `yaml:"role_arn,omitempty"`
-	RoleSessionName string   `yaml:"role_session_name,omitempty"`
-	ExternalID      string   `yaml:"external_id,omitempty"`
-	DefaultRegion   string   `yaml:"default_region,omitempty"`
-	Regions         []string `yaml:"regions,omitempty"`
+	AccountName     string    `yaml:"account_name,omitempty"`
+	LocalProfile    string    `yaml:"local_profile,omitempty"`
+	RoleARN         string    `yaml:"role_arn,omitempty"`
+	RoleSessionName string    `yaml:"role_session_name,omitempty"`
+	ExternalID      string    `yaml:"external_id,omitempty"`
+	DefaultRegion   string    `yaml:"default_region,omitempty"`
+	Regions         []string  `yaml:"regions,omitempty"`
+	RoleChain       []RoleHop `yaml:"role_chain,omitempty"`
 	source          string
 }
 
+// RoleHop is a role assumed with the credentials of the previous hop in a role chain
+type RoleHop struct {
+	RoleARN         string `yaml:"role_arn"`
+	RoleSessionName string `yaml:"role_session_name,omitempty"`
+	ExternalID      string `yaml:"external_id,omitempty"`
+}
+
+// roleChain returns the roles to assume in order: the role_chain hops followed by role_arn, if set
+func (a Account) roleChain() []RoleHop {
+	chain := append([]RoleHop{}, a.RoleChain...)
+	if a.RoleARN != "" {
+		chain = append(chain, RoleHop{RoleARN: a.RoleARN, RoleSessionName: a.RoleSessionName, ExternalID: a.ExternalID})
+	}
+	return chain
+}
+
 type AwsOrg struct {
 	OrganizationUnits           []string `yaml:"organization_units,omitempty"`
 	AdminAccount                *Account `yaml:"admin_account"`
@@ -23,14 +40,39 @@ type AwsOrg struct {
 	ChildAccountRegions         []string `yaml:"member_regions,omitempty"`
 }
 
+type CircuitBreakerConfig struct {
+	FailureThreshold int `yaml:"failure_threshold,omitempty"`
+	Cooldown         int `yaml:"cooldown,omitempty"`
+}
+
+type ThrottleRetryConfig struct {
+	MaxAttempts int `yaml:"max_attempts,omitempty"`
+	MaxBackoff  int `yaml:"max_backoff,omitempty"`
+}
+
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst,omitempty"`
+}
+
+type MetricsConfig struct {
+	StatsdAddress string `yaml:"statsd_address,omitempty"`
+	Prefix        string `yaml:"prefix,omitempty"`
+}
+
 type Config struct {
-	Regions      []string  `yaml:"regions,omitempty"`
-	Accounts     []Account `yaml:"accounts"`
-	Organization *AwsOrg   `yaml:"org"`
-	AWSDebug     bool      `yaml:"aws_debug,omitempty"`
-	MaxRetries   int       `yaml:"max_retries,omitempty" default:"10"`
-	MaxBackoff   int       `yaml:"max_backoff,omitempty" default:"30"`
-	GlobalRegion string    `yaml:"global_region,omitempty" default:"us-east-1"`
+	Regions        []string                   `yaml:"regions,omitempty"`
+	Accounts       []Account                  `yaml:"accounts"`
+	Organization   *AwsOrg                    `yaml:"org"`
+	AWSDebug       bool                       `yaml:"aws_debug,omitempty"`
+	MaxRetries     int                        `yaml:"max_retries,omitempty" default:"10"`
+	MaxBackoff     int                        `yaml:"max_backoff,omitempty" default:"30"`
+	GlobalRegion   string                     `yaml:"global_region,omitempty" default:"us-east-1"`
+	CircuitBreaker *CircuitBreakerConfig      `yaml:"circuit_breaker,omitempty"`
+	WarnUntagged   bool                       `yaml:"warn_untagged,omitempty"`
+	Metrics        *MetricsConfig             `yaml:"metrics,omitempty"`
+	RateLimits     map[string]RateLimitConfig `yaml:"rate_limits,omitempty"`
+	ThrottleRetry  *ThrottleRetryConfig       `yaml:"throttle_retry,omitempty"`
 }
 
 func (Config) Example() string {
@@ -42,6 +84,11 @@ Optional. Role ARN we want to assume when accessing this account
     role_arn: < YOUR_ROLE_ARN >
 Optional. Named profile in config or credential file from where CQ should grab credentials
     local_profile: < PROFILE_NAME >
+Optional. Roles assumed in order before role_arn, each one with the credentials of the previous one
+    role_chain:
+      - role_arn: < JUMP_ROLE_ARN >
+        external_id: < EXTERNAL_ID >
+        role_session_name: < SESSION_NAME >
 Optional. by default assumes all regions
 regions:
   - us-east-1
@@ -52,5 +99,24 @@ The maximum number of times that a request will be retried for failures. Default
 max_retries: 10
 The maximum back off delay between attempts. The backoff delays exponentially with a jitter based on the number of attempts. Defaults to 30 seconds.
 max_backoff: 30
+Optional. Stop calling a service in a region after failure_threshold consecutive server or throttling errors, for cooldown seconds. Disabled by default.
+circuit_breaker:
+  failure_threshold: 5
+  cooldown: 60
+Optional. Emit a warning for every resource without tags in tables that support it. Defaults to false.
+warn_untagged: false
+Optional. Retry per resource detail calls that are still throttled after max_retries, with exponential backoff and jitter. Defaults to 3 attempts and 30 seconds.
+throttle_retry:
+  max_attempts: 3
+  max_backoff: 30
+Optional. Limit the API calls per second to a service, per account and region. Keys are service ids such as ec2, cloudwatch, firehose or kinesis.
+rate_limits:
+  ec2:
+    requests_per_second: 20
+    burst: 5
+Optional. Send per table, account and region row counts and fetch durations to a statsd server. Disabled by default.
+metrics:
+  statsd_address: localhost:8125
+  prefix: cloudquery.aws
 `
 }
@@ -53,6 +53,7 @@ const (
 	ElasticLoadBalancingService AWSService = "elasticloadbalancing"
 	GlueService                 AWSService = "glue"
 	GuardDutyService            AWSService = "guardduty"
+	Macie2Service               AWSService = "macie2"
 	RedshiftService             AWSService = "redshift"
 	Route53Service              AWSService = "route53"
 	S3Service                   AWSService = "s3"
@@ -152,6 +153,18 @@ func isSupportedServiceForRegion(service string, region string) bool {
 	return true
 }
 
+func isSupportedServiceForPartition(service string, partition string) bool {
+	readOnce.Do(func() {
+		supportedServiceRegion = readSupportedServiceRegions()
+	})
+
+	if supportedServiceRegion == nil || supportedServiceRegion.Partitions == nil {
+		return false
+	}
+
+	return supportedServiceRegion.Partitions[partition].Services[service] != nil
+}
+
 func getAvailableRegions() (map[string]bool, error) {
 	readOnce.Do(func() {
 		supportedServiceRegion = readSupportedServiceRegions()
@@ -205,8 +218,14 @@ func IgnoreAccessDeniedServiceDisabled(err error) bool {
 	return isAccessDeniedError(err)
 }
 
+// IgnoreResourceNotFound ignores errors of resources deleted between the list and detail calls of a fetch,
+// such as ResourceNotFoundException
+func IgnoreResourceNotFound(err error) bool {
+	return isNotFoundError(err)
+}
+
 func IgnoreCommonErrors(err error) bool {
-	if IgnoreAccessDeniedServiceDisabled(err) || IgnoreNotAvailableRegion(err) || IgnoreWithInvalidAction(err) || isNotFoundError(err) {
+	if IgnoreAccessDeniedServiceDisabled(err) || IgnoreNotAvailableRegion(err) || IgnoreWithInvalidAction(err) || IgnoreResourceNotFound(err) || isCircuitOpenError(err) {
 		return true
 	}
 	return false
@@ -257,3 +257,21 @@ func TestIsErrorRegex(t *testing.T) {
 		})
 	}
 }
+
+func TestIgnoreResourceNotFound(t *testing.T) {
+	notFound := &smithy.OperationError{
+		ServiceID:     "Firehose",
+		OperationName: "DescribeDeliveryStream",
+		Err: &smithy.GenericAPIError{
+			Code:    "ResourceNotFoundException",
+			Message: "Firehose stream1 under account 123456789012 not found.",
+			Fault:   smithy.FaultClient,
+		},
+	}
+	assert.True(t, IgnoreResourceNotFound(notFound))
+	assert.True(t, IgnoreCommonErrors(notFound))
+
+	assert.False(t, IgnoreResourceNotFound(&smithy.GenericAPIError{Code: "ValidationException"}))
+	assert.False(t, IgnoreResourceNotFound(errors.New("ResourceNotFoundException")))
+	assert.False(t, IgnoreResourceNotFound(nil))
+}
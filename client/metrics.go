@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+const defaultMetricsPrefix = "cloudquery.aws"
+
+// FetchMetrics describes a single partition (table X account X region) fetch
+type FetchMetrics struct {
+	Table     string
+	AccountID string
+	Region    string
+	Rows      int
+	Duration  time.Duration
+	Err       error
+}
+
+// MetricsSink receives FetchMetrics at the end of every partition fetch of a top level table
+type MetricsSink interface {
+	RecordFetch(m FetchMetrics)
+}
+
+// statsdSink writes DogStatsD-style lines over UDP, so a dropped packet never slows down a fetch
+type statsdSink struct {
+	conn   net.Conn
+	prefix string
+}
+
+func newStatsdSink(cfg *MetricsConfig) (MetricsSink, error) {
+	if cfg == nil || cfg.StatsdAddress == "" {
+		return nil, nil
+	}
+	conn, err := net.Dial("udp", cfg.StatsdAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to statsd at %s: %w", cfg.StatsdAddress, err)
+	}
+	prefix := cfg.Prefix
+	if prefix == "" {
+		prefix = defaultMetricsPrefix
+	}
+	return &statsdSink{conn: conn, prefix: prefix}, nil
+}
+
+func (s *statsdSink) RecordFetch(m FetchMetrics) {
+	tags := fmt.Sprintf("#table:%s,account_id:%s,region:%s,success:%t", m.Table, obfuscateAccountId(m.AccountID), m.Region, m.Err == nil)
+	lines := []string{
+		fmt.Sprintf("%s.fetch.rows:%d|g|%s", s.prefix, m.Rows, tags),
+		fmt.Sprintf("%s.fetch.duration:%d|ms|%s", s.prefix, m.Duration.Milliseconds(), tags),
+	}
+	// errors are ignored on purpose, metrics are best effort
+	_, _ = s.conn.Write([]byte(strings.Join(lines, "\n")))
+}
+
+// WithFetchMetrics wraps the resolver of every table in the map so that, when a metrics sink is configured,
+// the sink receives the row count and duration of each partition fetch. Without a sink the original resolver
+// is called directly.
+func WithFetchMetrics(tables map[string]*schema.Table) map[string]*schema.Table {
+	for _, t := range tables {
+		t.Resolver = fetchWithMetrics(t.Name, t.Resolver)
+	}
+	return tables
+}
+
+func fetchWithMetrics(table string, resolver schema.TableResolver) schema.TableResolver {
+	return func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) (err error) {
+		c, ok := meta.(*Client)
+		if !ok || c.metrics == nil {
+			return resolver(ctx, meta, parent, res)
+		}
+		start := time.Now()
+		rows := 0
+		counted := make(chan interface{})
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for item := range counted {
+				rows += itemCount(item)
+				res <- item
+			}
+		}()
+		defer func() {
+			close(counted)
+			<-done
+			c.metrics.RecordFetch(FetchMetrics{
+				Table:     table,
+				AccountID: c.AccountID,
+				Region:    c.Region,
+				Rows:      rows,
+				Duration:  time.Since(start),
+				Err:       err,
+			})
+		}()
+		return resolver(ctx, meta, parent, counted)
+	}
+}
+
+// itemCount returns the number of resources a resolver sent in one item, as resolvers may send slices
+func itemCount(item interface{}) int {
+	v := reflect.ValueOf(item)
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+		return v.Len()
+	}
+	return 1
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMetricsSink struct {
+	fetches []FetchMetrics
+}
+
+func (s *fakeMetricsSink) RecordFetch(m FetchMetrics) {
+	s.fetches = append(s.fetches, m)
+}
+
+func collect(t *testing.T, resolver schema.TableResolver, meta schema.ClientMeta) ([]interface{}, error) {
+	t.Helper()
+	res := make(chan interface{})
+	var items []interface{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for item := range res {
+			items = append(items, item)
+		}
+	}()
+	err := resolver(context.Background(), meta, nil, res)
+	close(res)
+	<-done
+	return items, err
+}
+
+func TestFetchWithMetrics(t *testing.T) {
+	sink := &fakeMetricsSink{}
+	c := NewAwsClient(hclog.NewNullLogger())
+	c.metrics = sink
+	meta := c.withPartitionAccountIDAndRegion("aws", "testAccount", "eu-west-1")
+
+	tables := WithFetchMetrics(map[string]*schema.Table{
+		"firehose.delivery_streams": {
+			Name: "aws_firehose_delivery_streams",
+			Resolver: func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+				res <- []string{"stream1", "stream2"}
+				res <- "stream3"
+				return nil
+			},
+		},
+		"kinesis.streams": {
+			Name: "aws_kinesis_streams",
+			Resolver: func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+				res <- "stream1"
+				return errors.New("throttled")
+			},
+		},
+	})
+
+	items, err := collect(t, tables["firehose.delivery_streams"].Resolver, meta)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{[]string{"stream1", "stream2"}, "stream3"}, items)
+
+	_, err = collect(t, tables["kinesis.streams"].Resolver, meta)
+	assert.EqualError(t, err, "throttled")
+
+	require.Len(t, sink.fetches, 2)
+	assert.Equal(t, "aws_firehose_delivery_streams", sink.fetches[0].Table)
+	assert.Equal(t, "testAccount", sink.fetches[0].AccountID)
+	assert.Equal(t, "eu-west-1", sink.fetches[0].Region)
+	assert.Equal(t, 3, sink.fetches[0].Rows)
+	assert.NoError(t, sink.fetches[0].Err)
+	assert.Equal(t, "aws_kinesis_streams", sink.fetches[1].Table)
+	assert.Equal(t, 1, sink.fetches[1].Rows)
+	assert.EqualError(t, sink.fetches[1].Err, "throttled")
+}
+
+func TestFetchWithMetricsDisabled(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger())
+	var called bool
+	resolver := fetchWithMetrics("aws_kinesis_streams", func(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+		called = true
+		res <- "stream1"
+		return nil
+	})
+	items, err := collect(t, resolver, &c)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Len(t, items, 1)
+}
+
+func TestStatsdSink(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	sink, err := newStatsdSink(&MetricsConfig{StatsdAddress: conn.LocalAddr().String()})
+	require.NoError(t, err)
+	sink.RecordFetch(FetchMetrics{Table: "aws_kinesis_streams", AccountID: "123456789012", Region: "us-east-1", Rows: 4, Duration: 1500 * time.Millisecond})
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	lines := strings.Split(string(buf[:n]), "\n")
+	tags := "#table:aws_kinesis_streams,account_id:" + obfuscateAccountId("123456789012") + ",region:us-east-1,success:true"
+	assert.Equal(t, []string{
+		"cloudquery.aws.fetch.rows:4|g|" + tags,
+		"cloudquery.aws.fetch.duration:1500|ms|" + tags,
+	}, lines)
+
+	sink, err = newStatsdSink(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, sink)
+}
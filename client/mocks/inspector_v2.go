@@ -35,6 +35,26 @@ func (m *MockInspectorV2Client) EXPECT() *MockInspectorV2ClientMockRecorder {
 	return m.recorder
 }
 
+// ListCoverage mocks base method.
+func (m *MockInspectorV2Client) ListCoverage(arg0 context.Context, arg1 *inspector2.ListCoverageInput, arg2 ...func(*inspector2.Options)) (*inspector2.ListCoverageOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCoverage", varargs...)
+	ret0, _ := ret[0].(*inspector2.ListCoverageOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCoverage indicates an expected call of ListCoverage.
+func (mr *MockInspectorV2ClientMockRecorder) ListCoverage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCoverage", reflect.TypeOf((*MockInspectorV2Client)(nil).ListCoverage), varargs...)
+}
+
 // ListFindings mocks base method.
 func (m *MockInspectorV2Client) ListFindings(arg0 context.Context, arg1 *inspector2.ListFindingsInput, arg2 ...func(*inspector2.Options)) (*inspector2.ListFindingsOutput, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +55,26 @@ func (mr *MockKinesisClientMockRecorder) DescribeStreamSummary(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStreamSummary", reflect.TypeOf((*MockKinesisClient)(nil).DescribeStreamSummary), varargs...)
 }
 
+// ListStreamConsumers mocks base method.
+func (m *MockKinesisClient) ListStreamConsumers(arg0 context.Context, arg1 *kinesis.ListStreamConsumersInput, arg2 ...func(*kinesis.Options)) (*kinesis.ListStreamConsumersOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStreamConsumers", varargs...)
+	ret0, _ := ret[0].(*kinesis.ListStreamConsumersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStreamConsumers indicates an expected call of ListStreamConsumers.
+func (mr *MockKinesisClientMockRecorder) ListStreamConsumers(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStreamConsumers", reflect.TypeOf((*MockKinesisClient)(nil).ListStreamConsumers), varargs...)
+}
+
 // ListStreams mocks base method.
 func (m *MockKinesisClient) ListStreams(arg0 context.Context, arg1 *kinesis.ListStreamsInput, arg2 ...func(*kinesis.Options)) (*kinesis.ListStreamsOutput, error) {
 	m.ctrl.T.Helper()
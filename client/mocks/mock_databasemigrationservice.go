@@ -35,6 +35,26 @@ func (m *MockDatabasemigrationserviceClient) EXPECT() *MockDatabasemigrationserv
 	return m.recorder
 }
 
+// DescribeEndpoints mocks base method.
+func (m *MockDatabasemigrationserviceClient) DescribeEndpoints(arg0 context.Context, arg1 *databasemigrationservice.DescribeEndpointsInput, arg2 ...func(*databasemigrationservice.Options)) (*databasemigrationservice.DescribeEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEndpoints", varargs...)
+	ret0, _ := ret[0].(*databasemigrationservice.DescribeEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpoints indicates an expected call of DescribeEndpoints.
+func (mr *MockDatabasemigrationserviceClientMockRecorder) DescribeEndpoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpoints", reflect.TypeOf((*MockDatabasemigrationserviceClient)(nil).DescribeEndpoints), varargs...)
+}
+
 // DescribeReplicationInstances mocks base method.
 func (m *MockDatabasemigrationserviceClient) DescribeReplicationInstances(arg0 context.Context, arg1 *databasemigrationservice.DescribeReplicationInstancesInput, arg2 ...func(*databasemigrationservice.Options)) (*databasemigrationservice.DescribeReplicationInstancesOutput, error) {
 	m.ctrl.T.Helper()
@@ -55,6 +75,26 @@ func (mr *MockDatabasemigrationserviceClientMockRecorder) DescribeReplicationIns
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationInstances", reflect.TypeOf((*MockDatabasemigrationserviceClient)(nil).DescribeReplicationInstances), varargs...)
 }
 
+// DescribeReplicationTasks mocks base method.
+func (m *MockDatabasemigrationserviceClient) DescribeReplicationTasks(arg0 context.Context, arg1 *databasemigrationservice.DescribeReplicationTasksInput, arg2 ...func(*databasemigrationservice.Options)) (*databasemigrationservice.DescribeReplicationTasksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeReplicationTasks", varargs...)
+	ret0, _ := ret[0].(*databasemigrationservice.DescribeReplicationTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationTasks indicates an expected call of DescribeReplicationTasks.
+func (mr *MockDatabasemigrationserviceClientMockRecorder) DescribeReplicationTasks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationTasks", reflect.TypeOf((*MockDatabasemigrationserviceClient)(nil).DescribeReplicationTasks), varargs...)
+}
+
 // ListTagsForResource mocks base method.
 func (m *MockDatabasemigrationserviceClient) ListTagsForResource(arg0 context.Context, arg1 *databasemigrationservice.ListTagsForResourceInput, arg2 ...func(*databasemigrationservice.Options)) (*databasemigrationservice.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,26 @@ func (m *MockEfsClient) EXPECT() *MockEfsClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeAccessPoints mocks base method.
+func (m *MockEfsClient) DescribeAccessPoints(arg0 context.Context, arg1 *efs.DescribeAccessPointsInput, arg2 ...func(*efs.Options)) (*efs.DescribeAccessPointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeAccessPoints", varargs...)
+	ret0, _ := ret[0].(*efs.DescribeAccessPointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeAccessPoints indicates an expected call of DescribeAccessPoints.
+func (mr *MockEfsClientMockRecorder) DescribeAccessPoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeAccessPoints", reflect.TypeOf((*MockEfsClient)(nil).DescribeAccessPoints), varargs...)
+}
+
 // DescribeBackupPolicy mocks base method.
 func (m *MockEfsClient) DescribeBackupPolicy(arg0 context.Context, arg1 *efs.DescribeBackupPolicyInput, arg2 ...func(*efs.Options)) (*efs.DescribeBackupPolicyOutput, error) {
 	m.ctrl.T.Helper()
@@ -74,3 +94,43 @@ func (mr *MockEfsClientMockRecorder) DescribeFileSystems(arg0, arg1 interface{},
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFileSystems", reflect.TypeOf((*MockEfsClient)(nil).DescribeFileSystems), varargs...)
 }
+
+// DescribeLifecycleConfiguration mocks base method.
+func (m *MockEfsClient) DescribeLifecycleConfiguration(arg0 context.Context, arg1 *efs.DescribeLifecycleConfigurationInput, arg2 ...func(*efs.Options)) (*efs.DescribeLifecycleConfigurationOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeLifecycleConfiguration", varargs...)
+	ret0, _ := ret[0].(*efs.DescribeLifecycleConfigurationOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeLifecycleConfiguration indicates an expected call of DescribeLifecycleConfiguration.
+func (mr *MockEfsClientMockRecorder) DescribeLifecycleConfiguration(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeLifecycleConfiguration", reflect.TypeOf((*MockEfsClient)(nil).DescribeLifecycleConfiguration), varargs...)
+}
+
+// DescribeMountTargets mocks base method.
+func (m *MockEfsClient) DescribeMountTargets(arg0 context.Context, arg1 *efs.DescribeMountTargetsInput, arg2 ...func(*efs.Options)) (*efs.DescribeMountTargetsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeMountTargets", varargs...)
+	ret0, _ := ret[0].(*efs.DescribeMountTargetsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeMountTargets indicates an expected call of DescribeMountTargets.
+func (mr *MockEfsClientMockRecorder) DescribeMountTargets(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMountTargets", reflect.TypeOf((*MockEfsClient)(nil).DescribeMountTargets), varargs...)
+}
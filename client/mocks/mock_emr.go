@@ -94,3 +94,23 @@ func (mr *MockEmrClientMockRecorder) ListClusters(arg0, arg1 interface{}, arg2 .
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockEmrClient)(nil).ListClusters), varargs...)
 }
+
+// ListInstanceGroups mocks base method.
+func (m *MockEmrClient) ListInstanceGroups(arg0 context.Context, arg1 *emr.ListInstanceGroupsInput, arg2 ...func(*emr.Options)) (*emr.ListInstanceGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroups", varargs...)
+	ret0, _ := ret[0].(*emr.ListInstanceGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceGroups indicates an expected call of ListInstanceGroups.
+func (mr *MockEmrClientMockRecorder) ListInstanceGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroups", reflect.TypeOf((*MockEmrClient)(nil).ListInstanceGroups), varargs...)
+}
@@ -55,6 +55,26 @@ func (mr *MockGuardDutyClientMockRecorder) GetDetector(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetector", reflect.TypeOf((*MockGuardDutyClient)(nil).GetDetector), varargs...)
 }
 
+// GetFindings mocks base method.
+func (m *MockGuardDutyClient) GetFindings(arg0 context.Context, arg1 *guardduty.GetFindingsInput, arg2 ...func(*guardduty.Options)) (*guardduty.GetFindingsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFindings", varargs...)
+	ret0, _ := ret[0].(*guardduty.GetFindingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFindings indicates an expected call of GetFindings.
+func (mr *MockGuardDutyClientMockRecorder) GetFindings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFindings", reflect.TypeOf((*MockGuardDutyClient)(nil).GetFindings), varargs...)
+}
+
 // ListDetectors mocks base method.
 func (m *MockGuardDutyClient) ListDetectors(arg0 context.Context, arg1 *guardduty.ListDetectorsInput, arg2 ...func(*guardduty.Options)) (*guardduty.ListDetectorsOutput, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +95,26 @@ func (mr *MockGuardDutyClientMockRecorder) ListDetectors(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetectors", reflect.TypeOf((*MockGuardDutyClient)(nil).ListDetectors), varargs...)
 }
 
+// ListFindings mocks base method.
+func (m *MockGuardDutyClient) ListFindings(arg0 context.Context, arg1 *guardduty.ListFindingsInput, arg2 ...func(*guardduty.Options)) (*guardduty.ListFindingsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFindings", varargs...)
+	ret0, _ := ret[0].(*guardduty.ListFindingsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFindings indicates an expected call of ListFindings.
+func (mr *MockGuardDutyClientMockRecorder) ListFindings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFindings", reflect.TypeOf((*MockGuardDutyClient)(nil).ListFindings), varargs...)
+}
+
 // ListMembers mocks base method.
 func (m *MockGuardDutyClient) ListMembers(arg0 context.Context, arg1 *guardduty.ListMembersInput, arg2 ...func(*guardduty.Options)) (*guardduty.ListMembersOutput, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +215,26 @@ func (mr *MockLambdaClientMockRecorder) ListFunctionEventInvokeConfigs(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionEventInvokeConfigs", reflect.TypeOf((*MockLambdaClient)(nil).ListFunctionEventInvokeConfigs), varargs...)
 }
 
+// ListFunctionUrlConfigs mocks base method.
+func (m *MockLambdaClient) ListFunctionUrlConfigs(arg0 context.Context, arg1 *lambda.ListFunctionUrlConfigsInput, arg2 ...func(*lambda.Options)) (*lambda.ListFunctionUrlConfigsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFunctionUrlConfigs", varargs...)
+	ret0, _ := ret[0].(*lambda.ListFunctionUrlConfigsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFunctionUrlConfigs indicates an expected call of ListFunctionUrlConfigs.
+func (mr *MockLambdaClientMockRecorder) ListFunctionUrlConfigs(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFunctionUrlConfigs", reflect.TypeOf((*MockLambdaClient)(nil).ListFunctionUrlConfigs), varargs...)
+}
+
 // ListFunctions mocks base method.
 func (m *MockLambdaClient) ListFunctions(arg0 context.Context, arg1 *lambda.ListFunctionsInput, arg2 ...func(*lambda.Options)) (*lambda.ListFunctionsOutput, error) {
 	m.ctrl.T.Helper()
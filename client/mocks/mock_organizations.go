@@ -35,6 +35,26 @@ func (m *MockOrganizationsClient) EXPECT() *MockOrganizationsClientMockRecorder
 	return m.recorder
 }
 
+// DescribePolicy mocks base method.
+func (m *MockOrganizationsClient) DescribePolicy(arg0 context.Context, arg1 *organizations.DescribePolicyInput, arg2 ...func(*organizations.Options)) (*organizations.DescribePolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribePolicy", varargs...)
+	ret0, _ := ret[0].(*organizations.DescribePolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePolicy indicates an expected call of DescribePolicy.
+func (mr *MockOrganizationsClientMockRecorder) DescribePolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePolicy", reflect.TypeOf((*MockOrganizationsClient)(nil).DescribePolicy), varargs...)
+}
+
 // ListAccounts mocks base method.
 func (m *MockOrganizationsClient) ListAccounts(arg0 context.Context, arg1 *organizations.ListAccountsInput, arg2 ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +95,26 @@ func (mr *MockOrganizationsClientMockRecorder) ListAccountsForParent(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsForParent", reflect.TypeOf((*MockOrganizationsClient)(nil).ListAccountsForParent), varargs...)
 }
 
+// ListPolicies mocks base method.
+func (m *MockOrganizationsClient) ListPolicies(arg0 context.Context, arg1 *organizations.ListPoliciesInput, arg2 ...func(*organizations.Options)) (*organizations.ListPoliciesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPolicies", varargs...)
+	ret0, _ := ret[0].(*organizations.ListPoliciesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockOrganizationsClientMockRecorder) ListPolicies(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockOrganizationsClient)(nil).ListPolicies), varargs...)
+}
+
 // ListTagsForResource mocks base method.
 func (m *MockOrganizationsClient) ListTagsForResource(arg0 context.Context, arg1 *organizations.ListTagsForResourceInput, arg2 ...func(*organizations.Options)) (*organizations.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
@@ -94,3 +134,23 @@ func (mr *MockOrganizationsClientMockRecorder) ListTagsForResource(arg0, arg1 in
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsForResource", reflect.TypeOf((*MockOrganizationsClient)(nil).ListTagsForResource), varargs...)
 }
+
+// ListTargetsForPolicy mocks base method.
+func (m *MockOrganizationsClient) ListTargetsForPolicy(arg0 context.Context, arg1 *organizations.ListTargetsForPolicyInput, arg2 ...func(*organizations.Options)) (*organizations.ListTargetsForPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTargetsForPolicy", varargs...)
+	ret0, _ := ret[0].(*organizations.ListTargetsForPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTargetsForPolicy indicates an expected call of ListTargetsForPolicy.
+func (mr *MockOrganizationsClientMockRecorder) ListTargetsForPolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTargetsForPolicy", reflect.TypeOf((*MockOrganizationsClient)(nil).ListTargetsForPolicy), varargs...)
+}
@@ -35,6 +35,26 @@ func (m *MockRedshiftClient) EXPECT() *MockRedshiftClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeClusterParameterGroups mocks base method.
+func (m *MockRedshiftClient) DescribeClusterParameterGroups(arg0 context.Context, arg1 *redshift.DescribeClusterParameterGroupsInput, arg2 ...func(*redshift.Options)) (*redshift.DescribeClusterParameterGroupsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeClusterParameterGroups", varargs...)
+	ret0, _ := ret[0].(*redshift.DescribeClusterParameterGroupsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeClusterParameterGroups indicates an expected call of DescribeClusterParameterGroups.
+func (mr *MockRedshiftClientMockRecorder) DescribeClusterParameterGroups(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeClusterParameterGroups", reflect.TypeOf((*MockRedshiftClient)(nil).DescribeClusterParameterGroups), varargs...)
+}
+
 // DescribeClusterParameters mocks base method.
 func (m *MockRedshiftClient) DescribeClusterParameters(arg0 context.Context, arg1 *redshift.DescribeClusterParametersInput, arg2 ...func(*redshift.Options)) (*redshift.DescribeClusterParametersOutput, error) {
 	m.ctrl.T.Helper()
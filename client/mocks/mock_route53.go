@@ -255,6 +255,26 @@ func (mr *MockRoute53ClientMockRecorder) ListTrafficPolicyInstancesByHostedZone(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficPolicyInstancesByHostedZone", reflect.TypeOf((*MockRoute53Client)(nil).ListTrafficPolicyInstancesByHostedZone), varargs...)
 }
 
+// ListTrafficPolicyInstancesByPolicy mocks base method.
+func (m *MockRoute53Client) ListTrafficPolicyInstancesByPolicy(arg0 context.Context, arg1 *route53.ListTrafficPolicyInstancesByPolicyInput, arg2 ...func(*route53.Options)) (*route53.ListTrafficPolicyInstancesByPolicyOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficPolicyInstancesByPolicy", varargs...)
+	ret0, _ := ret[0].(*route53.ListTrafficPolicyInstancesByPolicyOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficPolicyInstancesByPolicy indicates an expected call of ListTrafficPolicyInstancesByPolicy.
+func (mr *MockRoute53ClientMockRecorder) ListTrafficPolicyInstancesByPolicy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficPolicyInstancesByPolicy", reflect.TypeOf((*MockRoute53Client)(nil).ListTrafficPolicyInstancesByPolicy), varargs...)
+}
+
 // ListTrafficPolicyVersions mocks base method.
 func (m *MockRoute53Client) ListTrafficPolicyVersions(arg0 context.Context, arg1 *route53.ListTrafficPolicyVersionsInput, arg2 ...func(*route53.Options)) (*route53.ListTrafficPolicyVersionsOutput, error) {
 	m.ctrl.T.Helper()
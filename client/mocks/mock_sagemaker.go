@@ -35,6 +35,26 @@ func (m *MockSageMakerClient) EXPECT() *MockSageMakerClientMockRecorder {
 	return m.recorder
 }
 
+// DescribeEndpoint mocks base method.
+func (m *MockSageMakerClient) DescribeEndpoint(arg0 context.Context, arg1 *sagemaker.DescribeEndpointInput, arg2 ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeEndpoint", varargs...)
+	ret0, _ := ret[0].(*sagemaker.DescribeEndpointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeEndpoint indicates an expected call of DescribeEndpoint.
+func (mr *MockSageMakerClientMockRecorder) DescribeEndpoint(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEndpoint", reflect.TypeOf((*MockSageMakerClient)(nil).DescribeEndpoint), varargs...)
+}
+
 // DescribeEndpointConfig mocks base method.
 func (m *MockSageMakerClient) DescribeEndpointConfig(arg0 context.Context, arg1 *sagemaker.DescribeEndpointConfigInput, arg2 ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointConfigOutput, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +155,26 @@ func (mr *MockSageMakerClientMockRecorder) ListEndpointConfigs(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointConfigs", reflect.TypeOf((*MockSageMakerClient)(nil).ListEndpointConfigs), varargs...)
 }
 
+// ListEndpoints mocks base method.
+func (m *MockSageMakerClient) ListEndpoints(arg0 context.Context, arg1 *sagemaker.ListEndpointsInput, arg2 ...func(*sagemaker.Options)) (*sagemaker.ListEndpointsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEndpoints", varargs...)
+	ret0, _ := ret[0].(*sagemaker.ListEndpointsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEndpoints indicates an expected call of ListEndpoints.
+func (mr *MockSageMakerClientMockRecorder) ListEndpoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpoints", reflect.TypeOf((*MockSageMakerClient)(nil).ListEndpoints), varargs...)
+}
+
 // ListModels mocks base method.
 func (m *MockSageMakerClient) ListModels(arg0 context.Context, arg1 *sagemaker.ListModelsInput, arg2 ...func(*sagemaker.Options)) (*sagemaker.ListModelsOutput, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +75,26 @@ func (mr *MockSecretsManagerClientMockRecorder) GetResourcePolicy(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourcePolicy", reflect.TypeOf((*MockSecretsManagerClient)(nil).GetResourcePolicy), varargs...)
 }
 
+// ListSecretVersionIds mocks base method.
+func (m *MockSecretsManagerClient) ListSecretVersionIds(arg0 context.Context, arg1 *secretsmanager.ListSecretVersionIdsInput, arg2 ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecretVersionIds", varargs...)
+	ret0, _ := ret[0].(*secretsmanager.ListSecretVersionIdsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersionIds indicates an expected call of ListSecretVersionIds.
+func (mr *MockSecretsManagerClientMockRecorder) ListSecretVersionIds(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersionIds", reflect.TypeOf((*MockSecretsManagerClient)(nil).ListSecretVersionIds), varargs...)
+}
+
 // ListSecrets mocks base method.
 func (m *MockSecretsManagerClient) ListSecrets(arg0 context.Context, arg1 *secretsmanager.ListSecretsInput, arg2 ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretsOutput, error) {
 	m.ctrl.T.Helper()
@@ -35,6 +35,26 @@ func (m *MockSnsClient) EXPECT() *MockSnsClientMockRecorder {
 	return m.recorder
 }
 
+// GetSubscriptionAttributes mocks base method.
+func (m *MockSnsClient) GetSubscriptionAttributes(arg0 context.Context, arg1 *sns.GetSubscriptionAttributesInput, arg2 ...func(*sns.Options)) (*sns.GetSubscriptionAttributesOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSubscriptionAttributes", varargs...)
+	ret0, _ := ret[0].(*sns.GetSubscriptionAttributesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptionAttributes indicates an expected call of GetSubscriptionAttributes.
+func (mr *MockSnsClientMockRecorder) GetSubscriptionAttributes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionAttributes", reflect.TypeOf((*MockSnsClient)(nil).GetSubscriptionAttributes), varargs...)
+}
+
 // GetTopicAttributes mocks base method.
 func (m *MockSnsClient) GetTopicAttributes(arg0 context.Context, arg1 *sns.GetTopicAttributesInput, arg2 ...func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
 	m.ctrl.T.Helper()
@@ -75,6 +95,26 @@ func (mr *MockSnsClientMockRecorder) ListSubscriptions(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockSnsClient)(nil).ListSubscriptions), varargs...)
 }
 
+// ListSubscriptionsByTopic mocks base method.
+func (m *MockSnsClient) ListSubscriptionsByTopic(arg0 context.Context, arg1 *sns.ListSubscriptionsByTopicInput, arg2 ...func(*sns.Options)) (*sns.ListSubscriptionsByTopicOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSubscriptionsByTopic", varargs...)
+	ret0, _ := ret[0].(*sns.ListSubscriptionsByTopicOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionsByTopic indicates an expected call of ListSubscriptionsByTopic.
+func (mr *MockSnsClientMockRecorder) ListSubscriptionsByTopic(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionsByTopic", reflect.TypeOf((*MockSnsClient)(nil).ListSubscriptionsByTopic), varargs...)
+}
+
 // ListTagsForResource mocks base method.
 func (m *MockSnsClient) ListTagsForResource(arg0 context.Context, arg1 *sns.ListTagsForResourceInput, arg2 ...func(*sns.Options)) (*sns.ListTagsForResourceOutput, error) {
 	m.ctrl.T.Helper()
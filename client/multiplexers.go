@@ -38,13 +38,53 @@ func AccountMultiplex(meta schema.ClientMeta) []schema.ClientMeta {
 	return l
 }
 
+// partitionGlobalRegions are the regions the endpoints of global services are located in
+var partitionGlobalRegions = map[string]string{
+	"aws":        "us-east-1",
+	"aws-cn":     "cn-north-1",
+	"aws-us-gov": "us-gov-west-1",
+}
+
+// ServiceAccountMultiplexer multiplexes global services (IAM, Route53, CloudFront, WAF Classic, Shield, Organizations)
+// once per account, in the global region of the partition. Unlike AccountMultiplex, the region is stable between
+// fetches, and partitions that don't offer the service are skipped.
+func ServiceAccountMultiplexer(service string) func(meta schema.ClientMeta) []schema.ClientMeta {
+	return func(meta schema.ClientMeta) []schema.ClientMeta {
+		var l = make([]schema.ClientMeta, 0)
+		client := meta.(*Client)
+		for partition := range client.ServicesManager.services {
+			if !isSupportedServiceForPartition(service, partition) {
+				meta.Logger().Trace("partition is not supported for service", "service", service, "partition", partition)
+				continue
+			}
+			for accountID, regions := range client.ServicesManager.services[partition] {
+				region := client.globalRegion(partition)
+				// fall back to an initialized region so Services() is always available
+				if _, ok := regions[region]; !ok {
+					region = getRegion(regions)
+				}
+				l = append(l, client.withPartitionAccountIDAndRegion(partition, accountID, region))
+			}
+		}
+		return l
+	}
+}
+
+// globalRegion returns the region global services are called in for the partition
+func (c *Client) globalRegion(partition string) string {
+	if partition == defaultPartition && c.GlobalRegion != "" {
+		return c.GlobalRegion
+	}
+	return partitionGlobalRegions[partition]
+}
+
 func ServiceAccountRegionMultiplexer(service string) func(meta schema.ClientMeta) []schema.ClientMeta {
 	return func(meta schema.ClientMeta) []schema.ClientMeta {
 		var l = make([]schema.ClientMeta, 0)
 		client := meta.(*Client)
 		for partition := range client.ServicesManager.services {
 			for accountID := range client.ServicesManager.services[partition] {
-				for region := range client.ServicesManager.services[partition][accountID] {
+				for _, region := range client.EnabledRegions(partition, accountID) {
 					if !isSupportedServiceForRegion(service, region) {
 						meta.Logger().Trace("region is not supported for service", "service", service, "region", region, "partition", partition)
 						continue
@@ -63,7 +103,7 @@ func ServiceAccountRegionNamespaceMultiplexer(service string) func(meta schema.C
 		client := meta.(*Client)
 		for partition := range client.ServicesManager.services {
 			for accountID := range client.ServicesManager.services[partition] {
-				for region := range client.ServicesManager.services[partition][accountID] {
+				for _, region := range client.EnabledRegions(partition, accountID) {
 					if !isSupportedServiceForRegion(service, region) {
 						meta.Logger().Trace("region is not supported for service", "service", service, "region", region)
 						continue
@@ -86,7 +126,7 @@ func ServiceAccountRegionScopeMultiplexer(service string) func(meta schema.Clien
 			for accountID := range client.ServicesManager.services[partition] {
 				// always fetch cloudfront related resources
 				l = append(l, client.withPartitionAccountIDRegionAndScope(partition, accountID, cloudfrontScopeRegion, wafv2types.ScopeCloudfront))
-				for region := range client.ServicesManager.services[partition][accountID] {
+				for _, region := range client.EnabledRegions(partition, accountID) {
 					if !isSupportedServiceForRegion(service, region) {
 						meta.Logger().Trace("region is not supported for service", "service", service, "region", region)
 						continue
@@ -0,0 +1,126 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnabledRegionsDescribedOncePerAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := NewAwsClient(hclog.NewNullLogger())
+	for _, account := range []string{"account1", "account2"} {
+		m := mocks.NewMockEc2Client(ctrl)
+		m.EXPECT().DescribeRegions(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(&ec2.DescribeRegionsOutput{
+			Regions: []types.Region{
+				{RegionName: aws.String("us-east-1"), OptInStatus: aws.String("opt-in-not-required")},
+				{RegionName: aws.String("eu-west-1"), OptInStatus: aws.String("opt-in-not-required")},
+				{RegionName: aws.String("af-south-1"), OptInStatus: aws.String("not-opted-in")},
+			},
+		}, nil)
+		for _, region := range []string{"us-east-1", "eu-west-1", "af-south-1"} {
+			c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", account, region, Services{EC2: m})
+		}
+	}
+
+	// every table calls its multiplexer, but regions must only be described once per account
+	for i := 0; i < 10; i++ {
+		clients := ServiceAccountRegionMultiplexer("ec2")(&c)
+		assert.Len(t, clients, 4)
+		for _, cl := range clients {
+			assert.Contains(t, []string{"us-east-1", "eu-west-1"}, cl.(*Client).Region)
+		}
+	}
+	assert.Equal(t, []string{"eu-west-1", "us-east-1"}, c.EnabledRegions("aws", "account1"))
+}
+
+func TestEnabledRegionsPreset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	c := NewAwsClient(hclog.NewNullLogger())
+	// no DescribeRegions expectations: regions set during configure must not be queried again
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "account1", "us-east-1", Services{EC2: mocks.NewMockEc2Client(ctrl)})
+	c.ServicesManager.SetEnabledRegions("aws", "account1", []string{"us-east-1"})
+
+	assert.Len(t, ServiceAccountRegionMultiplexer("ec2")(&c), 1)
+	assert.Equal(t, []string{"us-east-1"}, c.EnabledRegions("aws", "account1"))
+}
+
+func TestEnabledRegionsCacheFetchesOutsideLock(t *testing.T) {
+	c := newEnabledRegionsCache()
+	release := make(chan struct{})
+	var calls int32
+	slowFetch := func() []string {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return []string{"us-east-1"}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, []string{"us-east-1"}, c.get("aws", "slow", slowFetch))
+		}()
+	}
+	// another account must not wait for the slow fetch
+	done := make(chan []string)
+	go func() {
+		done <- c.get("aws", "fast", func() []string { return []string{"eu-west-1"} })
+	}()
+	select {
+	case regions := <-done:
+		assert.Equal(t, []string{"eu-west-1"}, regions)
+	case <-time.After(5 * time.Second):
+		t.Fatal("lookup of another account blocked by a running fetch")
+	}
+
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestServiceAccountMultiplexer(t *testing.T) {
+	c := NewAwsClient(hclog.NewNullLogger())
+	c.GlobalRegion = "us-east-1"
+	for _, account := range []string{"account1", "account2"} {
+		for _, region := range []string{"eu-west-1", "us-east-1", "us-west-2"} {
+			c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", account, region, Services{})
+		}
+	}
+	// the global region isn't initialized for this account, so any initialized region is used
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "account3", "eu-west-1", Services{})
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws-cn", "account4", "cn-north-1", Services{})
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws-cn", "account4", "cn-northwest-1", Services{})
+
+	// a single fetch per account, in the global region of the partition
+	regions := make(map[string]string)
+	for _, cl := range ServiceAccountMultiplexer("iam")(&c) {
+		client := cl.(*Client)
+		_, ok := regions[client.AccountID]
+		assert.False(t, ok, "account %s multiplexed more than once", client.AccountID)
+		regions[client.AccountID] = client.Region
+	}
+	assert.Equal(t, map[string]string{
+		"account1": "us-east-1",
+		"account2": "us-east-1",
+		"account3": "eu-west-1",
+		"account4": "cn-north-1",
+	}, regions)
+
+	// shield isn't offered in aws-cn
+	clients := ServiceAccountMultiplexer("shield")(&c)
+	assert.Len(t, clients, 3)
+	for _, cl := range clients {
+		assert.Equal(t, "aws", cl.(*Client).Partition)
+	}
+}
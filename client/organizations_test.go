@@ -28,6 +28,18 @@ func (mockOrgClient) ListTagsForResource(ctx context.Context, input *organizatio
 	return &organizations.ListTagsForResourceOutput{Tags: nil, NextToken: nil}, nil
 }
 
+func (mockOrgClient) DescribePolicy(ctx context.Context, input *organizations.DescribePolicyInput, f ...func(*organizations.Options)) (*organizations.DescribePolicyOutput, error) {
+	return &organizations.DescribePolicyOutput{}, nil
+}
+
+func (mockOrgClient) ListPolicies(ctx context.Context, input *organizations.ListPoliciesInput, f ...func(*organizations.Options)) (*organizations.ListPoliciesOutput, error) {
+	return &organizations.ListPoliciesOutput{}, nil
+}
+
+func (mockOrgClient) ListTargetsForPolicy(ctx context.Context, input *organizations.ListTargetsForPolicyInput, f ...func(*organizations.Options)) (*organizations.ListTargetsForPolicyOutput, error) {
+	return &organizations.ListTargetsForPolicyOutput{}, nil
+}
+
 func Test_Org_Configure(t *testing.T) {
 	ctx := context.Background()
 	tests := []struct {
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
+)
+
+// rateLimiter holds a token bucket per service+region for the services configured in rate_limits
+type rateLimiter struct {
+	limits map[string]RateLimitConfig
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// newRateLimiter returns nil when no rate limits are configured
+func newRateLimiter(limits map[string]RateLimitConfig) *rateLimiter {
+	normalized := make(map[string]RateLimitConfig, len(limits))
+	for service, limit := range limits {
+		if limit.RequestsPerSecond > 0 {
+			normalized[normalizeServiceID(service)] = limit
+		}
+	}
+	if len(normalized) == 0 {
+		return nil
+	}
+	return &rateLimiter{limits: normalized, limiters: make(map[string]*rate.Limiter)}
+}
+
+// limiter returns the token bucket of service in region, or nil if the service isn't rate limited
+func (r *rateLimiter) limiter(service, region string) *rate.Limiter {
+	service = normalizeServiceID(service)
+	limit, ok := r.limits[service]
+	if !ok {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := service + "/" + region
+	l, ok := r.limiters[key]
+	if !ok {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		l = rate.NewLimiter(rate.Limit(limit.RequestsPerSecond), burst)
+		r.limiters[key] = l
+	}
+	return l
+}
+
+// addMiddleware registers the limiter on an AWS SDK stack, so every API call of a rate limited service
+// waits for a token before it is sent
+func (r *rateLimiter) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("RateLimiter", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		if l := r.limiter(awsmiddleware.GetServiceID(ctx), awsmiddleware.GetRegion(ctx)); l != nil {
+			if err := l.Wait(ctx); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+		}
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// normalizeServiceID maps SDK service IDs such as "CloudWatch Logs" to the config keys, e.g. "cloudwatchlogs"
+func normalizeServiceID(service string) string {
+	return strings.ToLower(strings.ReplaceAll(service, " ", ""))
+}
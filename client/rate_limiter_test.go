@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterDisabledByDefault(t *testing.T) {
+	assert.Nil(t, newRateLimiter(nil))
+	assert.Nil(t, newRateLimiter(map[string]RateLimitConfig{"ec2": {}}))
+}
+
+func TestRateLimiterPerServiceAndRegion(t *testing.T) {
+	r := newRateLimiter(map[string]RateLimitConfig{
+		"ec2":            {RequestsPerSecond: 10, Burst: 5},
+		"CloudWatchLogs": {RequestsPerSecond: 2},
+	})
+	require.NotNil(t, r)
+	assert.Nil(t, r.limiter("Kinesis", "us-east-1"))
+
+	ec2 := r.limiter("EC2", "us-east-1")
+	require.NotNil(t, ec2)
+	assert.Equal(t, 5, ec2.Burst())
+	assert.Same(t, ec2, r.limiter("EC2", "us-east-1"))
+	assert.NotSame(t, ec2, r.limiter("EC2", "eu-west-1"))
+
+	logs := r.limiter("CloudWatch Logs", "us-east-1")
+	require.NotNil(t, logs)
+	assert.Equal(t, 1, logs.Burst())
+}
+
+func TestRateLimiterMiddleware(t *testing.T) {
+	r := newRateLimiter(map[string]RateLimitConfig{"firehose": {RequestsPerSecond: 0.001, Burst: 2}})
+	call := func(serviceID string) error {
+		stack := middleware.NewStack("test", smithyhttp.NewStackRequest)
+		require.NoError(t, stack.Initialize.Add(&awsmiddleware.RegisterServiceMetadata{ServiceID: serviceID, Region: "us-east-1"}, middleware.Before))
+		require.NoError(t, r.addMiddleware(stack))
+		handler := middleware.DecorateHandler(middleware.HandlerFunc(func(ctx context.Context, input interface{}) (interface{}, middleware.Metadata, error) {
+			return nil, middleware.Metadata{}, nil
+		}), stack)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err := handler.Handle(ctx, struct{}{})
+		return err
+	}
+
+	// the burst goes through, after that calls wait for a token and give up with the context
+	assert.NoError(t, call("Firehose"))
+	assert.NoError(t, call("Firehose"))
+	assert.Error(t, call("Firehose"))
+	// services without a limit are never blocked
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, call("Kinesis"))
+	}
+}
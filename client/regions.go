@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"golang.org/x/sync/singleflight"
+)
+
+// enabledRegionsCache holds the enabled regions of every (partition X account) so they are
+// computed once per provider run and every multiplexed table sees the same set.
+type enabledRegionsCache struct {
+	mu      sync.Mutex
+	regions map[string]map[string][]string
+	fetches singleflight.Group
+}
+
+func newEnabledRegionsCache() *enabledRegionsCache {
+	return &enabledRegionsCache{regions: make(map[string]map[string][]string)}
+}
+
+// get returns the cached regions of the account, calling fetch to populate the cache on a miss.
+// fetch runs without holding the lock, concurrent misses of the same account share a single fetch.
+func (c *enabledRegionsCache) get(partition, accountID string, fetch func() []string) []string {
+	if regions, ok := c.lookup(partition, accountID); ok {
+		return regions
+	}
+	v, _, _ := c.fetches.Do(partition+"/"+accountID, func() (interface{}, error) {
+		// a fetch that finished after our lookup already populated the cache
+		if regions, ok := c.lookup(partition, accountID); ok {
+			return regions, nil
+		}
+		regions := fetch()
+		sort.Strings(regions)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		// keep regions set with add while we were fetching
+		if existing, ok := c.regions[partition][accountID]; ok {
+			return existing, nil
+		}
+		c.setLocked(partition, accountID, regions)
+		return regions, nil
+	})
+	return v.([]string)
+}
+
+func (c *enabledRegionsCache) lookup(partition, accountID string) ([]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	regions, ok := c.regions[partition][accountID]
+	return regions, ok
+}
+
+// add merges regions into the cached set of the account
+func (c *enabledRegionsCache) add(partition, accountID string, regions []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	merged := make(map[string]bool)
+	for _, r := range c.regions[partition][accountID] {
+		merged[r] = true
+	}
+	for _, r := range regions {
+		merged[r] = true
+	}
+	result := make([]string, 0, len(merged))
+	for r := range merged {
+		result = append(result, r)
+	}
+	sort.Strings(result)
+	c.setLocked(partition, accountID, result)
+}
+
+func (c *enabledRegionsCache) setLocked(partition, accountID string, regions []string) {
+	if c.regions[partition] == nil {
+		c.regions[partition] = make(map[string][]string)
+	}
+	c.regions[partition][accountID] = regions
+}
+
+// EnabledRegions returns the enabled regions of the account that have initialized services.
+// The set is computed once per provider run (via EC2 DescribeRegions) and shared across all tables.
+func (c *Client) EnabledRegions(partition, accountID string) []string {
+	return c.ServicesManager.enabledRegions.get(partition, accountID, func() []string {
+		initialized := make([]string, 0, len(c.ServicesManager.services[partition][accountID]))
+		for region := range c.ServicesManager.services[partition][accountID] {
+			initialized = append(initialized, region)
+		}
+		if len(initialized) == 0 {
+			return initialized
+		}
+		svc := c.ServicesManager.services[partition][accountID][getRegion(c.ServicesManager.services[partition][accountID])]
+		res, err := svc.EC2.DescribeRegions(context.Background(), &ec2.DescribeRegionsInput{AllRegions: aws.Bool(false)})
+		if err != nil {
+			c.logger.Warn("failed to describe enabled regions, using configured regions", "account_id", obfuscateAccountId(accountID), "err", err)
+			return initialized
+		}
+		return filterDisabledRegions(initialized, res.Regions)
+	})
+}
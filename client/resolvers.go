@@ -84,6 +84,24 @@ func ResolveTimestampField(path string, rfcs ...string) func(_ context.Context,
 	}
 }
 
+// ResolveUntagged sets the column to whether the "tags" column resolved to an empty map. When warn_untagged is
+// enabled it also returns a warning diagnostic with the resource ARN. It must come after the "tags" and "arn" columns.
+func ResolveUntagged(_ context.Context, meta schema.ClientMeta, r *schema.Resource, c schema.Column) error {
+	tags := reflect.ValueOf(r.Get("tags"))
+	untagged := !tags.IsValid() || (tags.Kind() == reflect.Map && tags.Len() == 0)
+	if err := r.Set(c.Name, untagged); err != nil {
+		return diag.WrapError(err)
+	}
+	if !untagged || !meta.(*Client).warnUntagged {
+		return nil
+	}
+	return diag.NewBaseError(fmt.Errorf("resource %v has no tags", r.Get("arn")), diag.RESOLVING,
+		diag.WithType(diag.RESOLVING),
+		diag.WithSeverity(diag.WARNING),
+		diag.WithSummary("untagged resource"),
+	)
+}
+
 /*
 SliceJsonResolver resolves slice of objects into a map[string]interface{}.
 For example object: SliceJsonStruct{Nested: &SliceJsonStruct{
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	types1 "github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
 	types2 "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	"github.com/stretchr/testify/assert"
 )
@@ -68,6 +69,43 @@ func TestResolveTags(t *testing.T) {
 	}
 }
 
+func TestResolveUntagged(t *testing.T) {
+	cases := []struct {
+		Tags         map[string]string
+		WarnUntagged bool
+		Expected     bool
+		ExpectWarn   bool
+	}{
+		{Tags: map[string]string{"k1": "v1"}, WarnUntagged: true, Expected: false},
+		{Tags: map[string]string{}, WarnUntagged: false, Expected: true},
+		{Tags: map[string]string{}, WarnUntagged: true, Expected: true, ExpectWarn: true},
+	}
+
+	for _, tc := range cases {
+		ta := &schema.Table{
+			Columns: []schema.Column{
+				{Name: "tags", Type: schema.TypeJSON},
+				{Name: "arn", Type: schema.TypeString},
+				{Name: "is_untagged", Type: schema.TypeBool},
+			},
+		}
+		r := schema.NewResourceData(schema.PostgresDialect{}, ta, nil, nil, nil, time.Now())
+		assert.NoError(t, r.Set("tags", tc.Tags))
+		assert.NoError(t, r.Set("arn", "arn:aws:firehose:us-east-1:123456789012:deliverystream/test"))
+		err := ResolveUntagged(context.Background(), &Client{warnUntagged: tc.WarnUntagged}, r, ta.Columns[2])
+		assert.Equal(t, tc.Expected, r.Get("is_untagged"))
+		if !tc.ExpectWarn {
+			assert.NoError(t, err)
+			continue
+		}
+		var d diag.Diagnostic
+		if assert.ErrorAs(t, err, &d) {
+			assert.Equal(t, diag.WARNING, d.Severity())
+			assert.Contains(t, d.Error(), "deliverystream/test")
+		}
+	}
+}
+
 func TestResolveSliceJson(t *testing.T) {
 	cases := []struct {
 		InputItem    interface{}
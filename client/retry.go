@@ -1,6 +1,8 @@
 package client
 
 import (
+	"context"
+	"math/rand"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -8,6 +10,68 @@ import (
 	"github.com/hashicorp/go-hclog"
 )
 
+const (
+	defaultThrottleMaxAttempts = 3
+	defaultThrottleMaxBackoff  = 30 * time.Second
+)
+
+type throttleRetryOptions struct {
+	maxAttempts int
+	maxBackoff  time.Duration
+}
+
+func newThrottleRetryOptions(cfg *ThrottleRetryConfig) throttleRetryOptions {
+	if cfg == nil {
+		return throttleRetryOptions{}
+	}
+	return throttleRetryOptions{maxAttempts: cfg.MaxAttempts, maxBackoff: time.Second * time.Duration(cfg.MaxBackoff)}
+}
+
+func (o throttleRetryOptions) values() (int, time.Duration) {
+	maxAttempts, maxBackoff := o.maxAttempts, o.maxBackoff
+	if maxAttempts <= 0 {
+		maxAttempts = defaultThrottleMaxAttempts
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultThrottleMaxBackoff
+	}
+	return maxAttempts, maxBackoff
+}
+
+// RetryOnThrottle calls fn again, with exponential backoff and full jitter, while it fails with a throttling error.
+// It is meant for calls made per resource, where a throttle that outlasted the SDK retries shouldn't fail the whole table.
+func (c *Client) RetryOnThrottle(ctx context.Context, fn func() error) error {
+	return c.RetryOnError(ctx, IsErrorThrottle, fn)
+}
+
+// RetryOnError is like RetryOnThrottle, but retries while retryable reports true for the error fn returned.
+// Use it for service specific throttling codes that shouldn't be treated as throttles everywhere.
+func (c *Client) RetryOnError(ctx context.Context, retryable func(error) bool, fn func() error) error {
+	maxAttempts, maxBackoff := c.throttleRetry.values()
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !retryable(err) || attempt >= maxAttempts {
+			return err
+		}
+		delay := throttleBackoff(attempt, maxBackoff)
+		c.logger.Debug("throttled, waiting before retry...", "attempt", attempt, "duration", delay.String(), "err", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+// throttleBackoff returns a random delay up to min(maxBackoff, 2^(attempt-1) seconds)
+func throttleBackoff(attempt int, maxBackoff time.Duration) time.Duration {
+	backoff := maxBackoff
+	if attempt <= 30 && time.Second<<(attempt-1) < maxBackoff {
+		backoff = time.Second << (attempt - 1)
+	}
+	return time.Duration(rand.Int63n(int64(backoff))) + 1
+}
+
 type retryer struct {
 	aws.Retryer
 	logger hclog.Logger
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryOnThrottle(t *testing.T) {
+	throttled := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	limitExceeded := &smithy.GenericAPIError{Code: "LimitExceededException", Message: "Limit exceeded"}
+	c := NewAwsClient(hclog.NewNullLogger())
+	c.throttleRetry = throttleRetryOptions{maxAttempts: 4, maxBackoff: time.Millisecond}
+
+	cases := []struct {
+		name  string
+		errs  []error
+		calls int
+		err   error
+	}{
+		{"success", []error{nil}, 1, nil},
+		{"throttled then success", []error{throttled, throttled, nil}, 3, nil},
+		{"other errors aren't retried", []error{errors.New("invalid"), nil}, 1, errors.New("invalid")},
+		{"gives up after max attempts", []error{throttled, throttled, throttled, throttled, nil}, 4, throttled},
+		{"limit exceeded isn't a throttle", []error{limitExceeded, nil}, 1, limitExceeded},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			err := c.RetryOnThrottle(context.Background(), func() error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+			assert.Equal(t, tc.calls, calls)
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
+
+func TestThrottleBackoff(t *testing.T) {
+	for attempt := 1; attempt < 40; attempt++ {
+		d := throttleBackoff(attempt, 30*time.Second)
+		assert.Greater(t, d, time.Duration(0))
+		assert.LessOrEqual(t, d, 30*time.Second)
+		if attempt == 1 {
+			assert.LessOrEqual(t, d, time.Second)
+		}
+	}
+}
+
+func TestThrottleRetryDefaults(t *testing.T) {
+	maxAttempts, maxBackoff := newThrottleRetryOptions(nil).values()
+	assert.Equal(t, defaultThrottleMaxAttempts, maxAttempts)
+	assert.Equal(t, defaultThrottleMaxBackoff, maxBackoff)
+
+	maxAttempts, maxBackoff = newThrottleRetryOptions(&ThrottleRetryConfig{MaxAttempts: 1, MaxBackoff: 5}).values()
+	assert.Equal(t, 1, maxAttempts)
+	assert.Equal(t, 5*time.Second, maxBackoff)
+}
@@ -41,6 +41,7 @@ max_backoff: 60
 					Level: hclog.Warn,
 				}))
 				c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "testAccount", "us-east-1", builder(t, ctrl))
+				c.ServicesManager.SetEnabledRegions("aws", "testAccount", []string{"us-east-1"})
 				c.Partition = "aws"
 				return &c, nil
 			},
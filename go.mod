@@ -3,17 +3,19 @@ module github.com/cloudquery/cq-provider-aws
 go 1.18
 
 require (
-	github.com/aws/aws-sdk-go-v2 v1.16.10
+	github.com/aws/aws-sdk-go-v2 v1.18.1
 	github.com/aws/aws-sdk-go-v2/config v1.15.14
 	github.com/aws/aws-sdk-go-v2/credentials v1.12.9
 	github.com/aws/aws-sdk-go-v2/feature/s3/manager v1.11.20
 	github.com/aws/aws-sdk-go-v2/service/accessanalyzer v1.15.8
 	github.com/aws/aws-sdk-go-v2/service/acm v1.14.8
+	github.com/aws/aws-sdk-go-v2/service/acmpca v1.21.10
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.15.10
 	github.com/aws/aws-sdk-go-v2/service/apigatewayv2 v1.12.8
 	github.com/aws/aws-sdk-go-v2/service/applicationautoscaling v1.15.8
 	github.com/aws/aws-sdk-go-v2/service/appsync v1.15.1
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.23.5
+	github.com/aws/aws-sdk-go-v2/service/batch v1.18.4
 	github.com/aws/aws-sdk-go-v2/service/cloudformation v1.21.2
 	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.18.4
 	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.16.4
@@ -24,12 +26,14 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cognitoidentity v1.13.8
 	github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider v1.17.3
 	github.com/aws/aws-sdk-go-v2/service/configservice v1.21.4
+	github.com/aws/aws-sdk-go-v2/service/connect v1.26.0
 	github.com/aws/aws-sdk-go-v2/service/databasemigrationservice v1.20.0
 	github.com/aws/aws-sdk-go-v2/service/dax v1.11.8
 	github.com/aws/aws-sdk-go-v2/service/directconnect v1.17.8
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.15.9
-	github.com/aws/aws-sdk-go-v2/service/ec2 v1.47.1
+	github.com/aws/aws-sdk-go-v2/service/ec2 v1.102.0
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.17.8
+	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.16.0
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.18.11
 	github.com/aws/aws-sdk-go-v2/service/efs v1.17.6
 	github.com/aws/aws-sdk-go-v2/service/eks v1.21.4
@@ -42,7 +46,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/eventbridge v1.16.8
 	github.com/aws/aws-sdk-go-v2/service/firehose v1.14.10
 	github.com/aws/aws-sdk-go-v2/service/fsx v1.24.2
-	github.com/aws/aws-sdk-go-v2/service/guardduty v1.14.1
+	github.com/aws/aws-sdk-go-v2/service/guardduty v1.15.3
 	github.com/aws/aws-sdk-go-v2/service/iam v1.18.8
 	github.com/aws/aws-sdk-go-v2/service/inspector v1.12.11
 	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.7.3
@@ -51,6 +55,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kms v1.17.4
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.23.3
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.22.2
+	github.com/aws/aws-sdk-go-v2/service/macie2 v1.23.4
 	github.com/aws/aws-sdk-go-v2/service/mq v1.13.3
 	github.com/aws/aws-sdk-go-v2/service/organizations v1.16.3
 	github.com/aws/aws-sdk-go-v2/service/qldb v1.14.8
@@ -62,8 +67,10 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.21.8
 	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.34.0
+	github.com/aws/aws-sdk-go-v2/service/savingsplans v1.10.0
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.15.12
 	github.com/aws/aws-sdk-go-v2/service/sesv2 v1.13.8
+	github.com/aws/aws-sdk-go-v2/service/sfn v1.14.1
 	github.com/aws/aws-sdk-go-v2/service/sns v1.17.8
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.18.7
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.27.3
@@ -72,7 +79,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/wafv2 v1.20.4
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.19.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.13.8
-	github.com/aws/smithy-go v1.12.1
+	github.com/aws/smithy-go v1.13.5
 	github.com/basgys/goxml2json v1.1.0
 	github.com/bxcodec/faker v2.0.1+incompatible
 	github.com/cloudquery/cq-gen v0.0.9
@@ -87,6 +94,7 @@ require (
 	github.com/stretchr/testify v1.8.0
 	github.com/thoas/go-funk v0.9.2
 	golang.org/x/sync v0.0.0-20220722155255-886fb9371eb4
+	golang.org/x/time v0.0.0-20220722155302-e5dcc9cfc0b9
 )
 
 require (
@@ -110,15 +118,15 @@ require (
 	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.4.3 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.12.8 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.17 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.11 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.1.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.4.28 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.3.15 // indirect
 	github.com/aws/aws-sdk-go-v2/service/athena v1.16.0
 	github.com/aws/aws-sdk-go-v2/service/backup v1.16.3
 	github.com/aws/aws-sdk-go-v2/service/glue v1.28.1
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.9.3 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.7.8 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.8 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.9.28 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.13.8 // indirect
 	github.com/aws/aws-sdk-go-v2/service/shield v1.16.7
 	github.com/aws/aws-sdk-go-v2/service/sso v1.11.12 // indirect
@@ -6,6 +6,7 @@ import (
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-aws/resources/services/accessanalyzer"
 	"github.com/cloudquery/cq-provider-aws/resources/services/acm"
+	"github.com/cloudquery/cq-provider-aws/resources/services/acmpca"
 	"github.com/cloudquery/cq-provider-aws/resources/services/apigateway"
 	"github.com/cloudquery/cq-provider-aws/resources/services/apigatewayv2"
 	"github.com/cloudquery/cq-provider-aws/resources/services/applicationautoscaling"
@@ -13,6 +14,7 @@ import (
 	"github.com/cloudquery/cq-provider-aws/resources/services/athena"
 	"github.com/cloudquery/cq-provider-aws/resources/services/autoscaling"
 	"github.com/cloudquery/cq-provider-aws/resources/services/backup"
+	"github.com/cloudquery/cq-provider-aws/resources/services/batch"
 	"github.com/cloudquery/cq-provider-aws/resources/services/cloudformation"
 	"github.com/cloudquery/cq-provider-aws/resources/services/cloudfront"
 	"github.com/cloudquery/cq-provider-aws/resources/services/cloudtrail"
@@ -22,6 +24,7 @@ import (
 	"github.com/cloudquery/cq-provider-aws/resources/services/codepipeline"
 	"github.com/cloudquery/cq-provider-aws/resources/services/cognito"
 	"github.com/cloudquery/cq-provider-aws/resources/services/config"
+	"github.com/cloudquery/cq-provider-aws/resources/services/connect"
 	"github.com/cloudquery/cq-provider-aws/resources/services/dax"
 	"github.com/cloudquery/cq-provider-aws/resources/services/directconnect"
 	"github.com/cloudquery/cq-provider-aws/resources/services/dms"
@@ -50,6 +53,7 @@ import (
 	"github.com/cloudquery/cq-provider-aws/resources/services/kms"
 	"github.com/cloudquery/cq-provider-aws/resources/services/lambda"
 	"github.com/cloudquery/cq-provider-aws/resources/services/lightsail"
+	"github.com/cloudquery/cq-provider-aws/resources/services/macie2"
 	"github.com/cloudquery/cq-provider-aws/resources/services/mq"
 	"github.com/cloudquery/cq-provider-aws/resources/services/organizations"
 	"github.com/cloudquery/cq-provider-aws/resources/services/qldb"
@@ -59,8 +63,10 @@ import (
 	"github.com/cloudquery/cq-provider-aws/resources/services/route53"
 	"github.com/cloudquery/cq-provider-aws/resources/services/s3"
 	"github.com/cloudquery/cq-provider-aws/resources/services/sagemaker"
+	"github.com/cloudquery/cq-provider-aws/resources/services/savingsplans"
 	"github.com/cloudquery/cq-provider-aws/resources/services/secretsmanager"
 	"github.com/cloudquery/cq-provider-aws/resources/services/ses"
+	"github.com/cloudquery/cq-provider-aws/resources/services/sfn"
 	"github.com/cloudquery/cq-provider-aws/resources/services/shield"
 	"github.com/cloudquery/cq-provider-aws/resources/services/sns"
 	"github.com/cloudquery/cq-provider-aws/resources/services/sqs"
@@ -89,9 +95,10 @@ func Provider() *provider.Provider {
 		Configure:        client.Configure,
 		ErrorClassifier:  client.ErrorClassifier,
 		ModuleInfoReader: module.EmbeddedReader(moduleData, "moduledata"),
-		ResourceMap: map[string]*schema.Table{
+		ResourceMap: client.WithFetchMetrics(map[string]*schema.Table{
 			"accessanalyzer.analyzers":                accessanalyzer.Analyzers(),
 			"acm.certificates":                        acm.AcmCertificates(),
+			"acmpca.certificate_authorities":          acmpca.CertificateAuthorities(),
 			"apigateway.api_keys":                     apigateway.ApigatewayAPIKeys(),
 			"apigateway.client_certificates":          apigateway.ApigatewayClientCertificates(),
 			"apigateway.domain_names":                 apigateway.ApigatewayDomainNames(),
@@ -113,9 +120,13 @@ func Provider() *provider.Provider {
 			"backup.plans":                            backup.Plans(),
 			"backup.region_settings":                  backup.RegionSettings(),
 			"backup.vaults":                           backup.Vaults(),
+			"batch.compute_environments":              batch.ComputeEnvironments(),
+			"batch.job_queues":                        batch.JobQueues(),
 			"cloudformation.stacks":                   cloudformation.Stacks(),
 			"cloudfront.cache_policies":               cloudfront.CloudfrontCachePolicies(),
 			"cloudfront.distributions":                cloudfront.CloudfrontDistributions(),
+			"cloudfront.functions":                    cloudfront.CloudfrontFunctions(),
+			"cloudfront.origin_access_identities":     cloudfront.CloudfrontOriginAccessIdentities(),
 			"cloudtrail.trails":                       cloudtrail.CloudtrailTrails(),
 			"cloudwatch.alarms":                       cloudwatch.CloudwatchAlarms(),
 			"cloudwatchlogs.filters":                  cloudwatchlogs.CloudwatchlogsFilters(),
@@ -127,6 +138,7 @@ func Provider() *provider.Provider {
 			"cognito.user_pools":                      cognito.CognitoUserPools(),
 			"config.configuration_recorders":          config.ConfigConfigurationRecorders(),
 			"config.conformance_packs":                config.ConfigConformancePack(),
+			"connect.instances":                       connect.Instances(),
 			"dax.clusters":                            dax.DaxClusters(),
 			"directconnect.connections":               directconnect.DirectconnectConnections(),
 			"directconnect.gateways":                  directconnect.DirectconnectGateways(),
@@ -134,6 +146,7 @@ func Provider() *provider.Provider {
 			"directconnect.virtual_gateways":          directconnect.DirectconnectVirtualGateways(),
 			"directconnect.virtual_interfaces":        directconnect.DirectconnectVirtualInterfaces(),
 			"dms.replication_instances":               dms.DmsReplicationInstances(),
+			"dms.replication_tasks":                   dms.DmsReplicationTasks(),
 			"dynamodb.tables":                         dynamodb.DynamodbTables(),
 			"ec2.byoip_cidrs":                         ec2.Ec2ByoipCidrs(),
 			"ec2.customer_gateways":                   ec2.Ec2CustomerGateways(),
@@ -144,14 +157,17 @@ func Provider() *provider.Provider {
 			"ec2.flow_logs":                           ec2.Ec2FlowLogs(),
 			"ec2.hosts":                               ec2.Hosts(),
 			"ec2.images":                              ec2.Ec2Images(),
+			"ec2.instance_connect_endpoints":          ec2.Ec2InstanceConnectEndpoints(),
 			"ec2.instance_statuses":                   ec2.Ec2InstanceStatuses(),
 			"ec2.instance_types":                      ec2.InstanceTypes(),
 			"ec2.instances":                           ec2.Ec2Instances(),
 			"ec2.internet_gateways":                   ec2.Ec2InternetGateways(),
+			"ec2.launch_templates":                    ec2.Ec2LaunchTemplates(),
 			"ec2.nat_gateways":                        ec2.Ec2NatGateways(),
 			"ec2.network_acls":                        ec2.Ec2NetworkAcls(),
 			"ec2.network_interfaces":                  ec2.NetworkInterfaces(),
 			"ec2.regional_config":                     ec2.Ec2RegionalConfig(),
+			"ec2.reserved_instances":                  ec2.Ec2ReservedInstances(),
 			"ec2.route_tables":                        ec2.Ec2RouteTables(),
 			"ec2.security_groups":                     ec2.Ec2SecurityGroups(),
 			"ec2.subnets":                             ec2.Ec2Subnets(),
@@ -161,13 +177,17 @@ func Provider() *provider.Provider {
 			"ec2.vpc_endpoints":                       ec2.Ec2VpcEndpoints(),
 			"ec2.vpc_peering_connections":             ec2.Ec2VpcPeeringConnections(),
 			"ec2.vpcs":                                ec2.Ec2Vpcs(),
+			"ec2.vpn_connections":                     ec2.Ec2VpnConnections(),
 			"ec2.vpn_gateways":                        ec2.Ec2VpnGateways(),
+			"ecr.public_repositories":                 ecr.PublicRepositories(),
 			"ecr.repositories":                        ecr.Repositories(),
 			"ecs.clusters":                            ecs.Clusters(),
 			"ecs.task_definitions":                    ecs.EcsTaskDefinitions(),
 			"efs.filesystems":                         efs.EfsFilesystems(),
 			"eks.clusters":                            eks.EksClusters(),
 			"elasticache.clusters":                    elasticache.Clusters(),
+			"elasticache.parameter_groups":            elasticache.ParameterGroups(),
+			"elasticache.replication_groups":          elasticache.ReplicationGroups(),
 			"elasticbeanstalk.application_versions":   elasticbeanstalk.ApplicationVersions(),
 			"elasticbeanstalk.applications":           elasticbeanstalk.ElasticbeanstalkApplications(),
 			"elasticbeanstalk.environments":           elasticbeanstalk.ElasticbeanstalkEnvironments(),
@@ -203,6 +223,7 @@ func Provider() *provider.Provider {
 			"iam.users":                               iam.IamUsers(),
 			"iam.virtual_mfa_devices":                 iam.IamVirtualMfaDevices(),
 			"inspector.findings":                      inspector.Findings(),
+			"inspector2.coverage":                     inspector2.Coverage(),
 			"inspector2.findings":                     inspector2.Findings(),
 			"iot.billing_groups":                      iot.IotBillingGroups(),
 			"iot.ca_certificates":                     iot.IotCaCertificates(),
@@ -231,8 +252,12 @@ func Provider() *provider.Provider {
 			"lightsail.instances":                     lightsail.Instances(),
 			"lightsail.load_balancers":                lightsail.LoadBalancers(),
 			"lightsail.static_ips":                    lightsail.StaticIps(),
+			"macie2.classification_jobs":              macie2.ClassificationJobs(),
+			"macie2.findings":                         macie2.Findings(),
+			"macie2.session":                          macie2.Session(),
 			"mq.brokers":                              mq.Brokers(),
 			"organizations.accounts":                  organizations.Accounts(),
+			"organizations.policies":                  organizations.Policies(),
 			"qldb.ledgers":                            qldb.Ledgers(),
 			"rds.certificates":                        rds.RdsCertificates(),
 			"rds.cluster_parameter_groups":            rds.RdsClusterParameterGroups(),
@@ -246,6 +271,7 @@ func Provider() *provider.Provider {
 			"rds.instances":                           rds.RdsInstances(),
 			"redshift.clusters":                       redshift.RedshiftClusters(),
 			"redshift.event_subscriptions":            redshift.EventSubscriptions(),
+			"redshift.parameter_groups":               redshift.ParameterGroups(),
 			"redshift.subnet_groups":                  redshift.RedshiftSubnetGroups(),
 			"resourcegroups.resource_groups":          resourcegroups.ResourceGroups(),
 			"route53.domains":                         route53.Route53Domains(),
@@ -256,11 +282,14 @@ func Provider() *provider.Provider {
 			"s3.accounts":                             s3.Accounts(),
 			"s3.buckets":                              s3.Buckets(),
 			"sagemaker.endpoint_configurations":       sagemaker.SagemakerEndpointConfigurations(),
+			"sagemaker.endpoints":                     sagemaker.SagemakerEndpoints(),
 			"sagemaker.models":                        sagemaker.SagemakerModels(),
 			"sagemaker.notebook_instances":            sagemaker.SagemakerNotebookInstances(),
 			"sagemaker.training_jobs":                 sagemaker.SagemakerTrainingJobs(),
+			"savingsplans.savings_plans":              savingsplans.SavingsPlans(),
 			"secretsmanager.secrets":                  secretsmanager.SecretsmanagerSecrets(),
 			"ses.templates":                           ses.Templates(),
+			"sfn.state_machines":                      sfn.StateMachines(),
 			"shield.attacks":                          shield.Attacks(),
 			"shield.protections_groups":               shield.ProtectionGroups(),
 			"shield.protections":                      shield.Protections(),
@@ -270,6 +299,7 @@ func Provider() *provider.Provider {
 			"sqs.queues":                              sqs.SQSQueues(),
 			"ssm.documents":                           ssm.SsmDocuments(),
 			"ssm.instances":                           ssm.SsmInstances(),
+			"ssm.parameters":                          ssm.SsmParameters(),
 			"waf.rule_groups":                         waf.WafRuleGroups(),
 			"waf.rules":                               waf.WafRules(),
 			"waf.subscribed_rule_groups":              waf.WafSubscribedRuleGroups(),
@@ -289,7 +319,7 @@ func Provider() *provider.Provider {
 			"xray.groups":                             xray.Groups(),
 			"xray.sampling_rules":                     xray.SamplingRules(),
 			//"iot.security_profiles": 				 iot.IotSecurityProfiles(), //TODO disabled because of api error NotFoundException: No method found matching route security-profiles for http method GET.
-		},
+		}),
 		Config: func() provider.Config {
 			return &client.Config{}
 		},
@@ -0,0 +1,202 @@
+package acmpca
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func CertificateAuthorities() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_acmpca_certificate_authorities",
+		Description:  "Contains information about your private certificate authority (CA).",
+		Resolver:     fetchAcmpcaCertificateAuthorities,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("acm-pca"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "Amazon Resource Name (ARN) for your private certificate authority (CA).",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "Type of your private CA.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "Status of your private CA.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "usage_mode",
+				Description: "Specifies whether the CA issues general-purpose certificates that typically require a revocation mechanism, or short-lived certificates that may optionally omit revocation because they expire quickly.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "key_algorithm",
+				Description: "Type of the public key algorithm and size, in bits, of the key pair that your CA creates when it issues a certificate.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("CertificateAuthorityConfiguration.KeyAlgorithm"),
+			},
+			{
+				Name:        "signing_algorithm",
+				Description: "Name of the algorithm your private CA uses to sign certificate requests.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("CertificateAuthorityConfiguration.SigningAlgorithm"),
+			},
+			{
+				Name:        "subject",
+				Description: "Structure that contains X.500 distinguished name information for your private CA.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("CertificateAuthorityConfiguration.Subject"),
+			},
+			{
+				Name:        "key_storage_security_standard",
+				Description: "Defines a cryptographic key management compliance standard used for handling CA keys.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "serial",
+				Description: "Serial number of your private CA.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "owner_account",
+				Description: "The Amazon Web Services account ID that owns the certificate authority.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "Reason the request to create your private CA failed.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "created_at",
+				Description: "Date and time at which your private CA was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "last_state_change_at",
+				Description: "Date and time at which your private CA was last updated.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "not_before",
+				Description: "Date and time before which your private CA certificate is not valid.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "not_after",
+				Description: "Date and time after which your private CA certificate is not valid.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "restorable_until",
+				Description: "The period during which a deleted CA can be restored.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "revocation_configuration",
+				Description: "Information about the Online Certificate Status Protocol (OCSP) configuration or certificate revocation list (CRL) created and maintained by your private CA.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("RevocationConfiguration"),
+			},
+			{
+				Name:        "policy",
+				Description: "The resource-based policy attached to the private CA, used for cross-account sharing.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAcmpcaCertificateAuthorityPolicy,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags associated with the private CA.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveAcmpcaCertificateAuthorityTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchAcmpcaCertificateAuthorities(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().ACMPCA
+	var input acmpca.ListCertificateAuthoritiesInput
+	for {
+		output, err := svc.ListCertificateAuthorities(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.CertificateAuthorities
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveAcmpcaCertificateAuthorityPolicy(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	svc := meta.(*client.Client).Services().ACMPCA
+	ca := resource.Item.(types.CertificateAuthority)
+	output, err := svc.GetPolicy(ctx, &acmpca.GetPolicyInput{ResourceArn: ca.Arn})
+	if err != nil {
+		if client.IsAWSError(err, "ResourceNotFoundException") {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if output.Policy == nil {
+		return nil
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(*output.Policy), &policy); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, policy))
+}
+
+func resolveAcmpcaCertificateAuthorityTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	svc := meta.(*client.Client).Services().ACMPCA
+	ca := resource.Item.(types.CertificateAuthority)
+	input := acmpca.ListTagsInput{CertificateAuthorityArn: ca.Arn}
+	tags := make(map[string]string)
+	for {
+		output, err := svc.ListTags(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for k, v := range client.TagsToMap(output.Tags) {
+			tags[k] = v
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return diag.WrapError(resource.Set(c.Name, tags))
+}
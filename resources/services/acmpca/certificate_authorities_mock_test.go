@@ -0,0 +1,45 @@
+package acmpca
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca"
+	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildAcmpcaCertificateAuthorities(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockACMPCAClient(ctrl)
+
+	var ca types.CertificateAuthority
+	if err := faker.FakeData(&ca); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListCertificateAuthorities(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&acmpca.ListCertificateAuthoritiesOutput{CertificateAuthorities: []types.CertificateAuthority{ca}},
+		nil,
+	)
+	m.EXPECT().GetPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&acmpca.GetPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)},
+		nil,
+	)
+
+	var tags acmpca.ListTagsOutput
+	if err := faker.FakeData(&tags); err != nil {
+		t.Fatal(err)
+	}
+	tags.NextToken = nil
+	m.EXPECT().ListTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(&tags, nil)
+
+	return client.Services{
+		ACMPCA: m,
+	}
+}
+
+func TestAcmpcaCertificateAuthorities(t *testing.T) {
+	client.AwsMockTestHelper(t, CertificateAuthorities(), buildAcmpcaCertificateAuthorities, client.TestOptions{})
+}
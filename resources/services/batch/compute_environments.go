@@ -0,0 +1,122 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func ComputeEnvironments() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_batch_compute_environments",
+		Description:  "An object that represents an Batch compute environment.",
+		Resolver:     fetchBatchComputeEnvironments,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("batch"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the compute environment.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ComputeEnvironmentArn"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the compute environment.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ComputeEnvironmentName"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of the compute environment: MANAGED or UNMANAGED.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the compute environment: ENABLED or DISABLED.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the compute environment (for example, CREATING or VALID).",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide additional details about the current status of the compute environment.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "service_role",
+				Description: "The service role associated with the compute environment that allows Batch to make calls to Amazon Web Services API operations on your behalf.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "ecs_cluster_arn",
+				Description: "The Amazon Resource Name (ARN) of the underlying Amazon ECS cluster used by the compute environment.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "unmanagedv_cpus",
+				Description: "The maximum number of VCPUs expected to be used for an unmanaged compute environment.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "compute_resources",
+				Description: "The compute resources defined for the compute environment, including security groups, subnets and the instance role.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ComputeResources"),
+			},
+			{
+				Name:        "update_policy",
+				Description: "Specifies the infrastructure update policy for the compute environment.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("UpdatePolicy"),
+			},
+			{
+				Name:        "tags",
+				Description: "The tags applied to the compute environment.",
+				Type:        schema.TypeJSON,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchBatchComputeEnvironments(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Batch
+	input := batch.DescribeComputeEnvironmentsInput{}
+	for {
+		output, err := svc.DescribeComputeEnvironments(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.ComputeEnvironments
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildBatchComputeEnvironments(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockBatchClient(ctrl)
+
+	var env types.ComputeEnvironmentDetail
+	if err := faker.FakeData(&env); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeComputeEnvironments(gomock.Any(), &batch.DescribeComputeEnvironmentsInput{}, gomock.Any()).Return(
+		&batch.DescribeComputeEnvironmentsOutput{ComputeEnvironments: []types.ComputeEnvironmentDetail{env}},
+		nil,
+	)
+
+	return client.Services{Batch: m}
+}
+
+func TestBatchComputeEnvironments(t *testing.T) {
+	client.AwsMockTestHelper(t, ComputeEnvironments(), buildBatchComputeEnvironments, client.TestOptions{})
+}
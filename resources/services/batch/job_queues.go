@@ -0,0 +1,106 @@
+package batch
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func JobQueues() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_batch_job_queues",
+		Description:  "An object that represents the details for an Batch job queue.",
+		Resolver:     fetchBatchJobQueues,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("batch"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job queue.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("JobQueueArn"),
+			},
+			{
+				Name:        "name",
+				Description: "The job queue name.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("JobQueueName"),
+			},
+			{
+				Name:        "state",
+				Description: "Describes the ability of the queue to accept new jobs: ENABLED or DISABLED.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the job queue (for example, CREATING or VALID).",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status_reason",
+				Description: "A short, human-readable string to provide additional details about the current status of the job queue.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "priority",
+				Description: "The priority of the job queue. Job queues with a higher priority are evaluated first when associated with the same compute environment.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "scheduling_policy_arn",
+				Description: "The Amazon Resource Name (ARN) of the scheduling policy.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "compute_environment_order",
+				Description: "The compute environments that are attached to the job queue and the order that job placement is preferred.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ComputeEnvironmentOrder"),
+			},
+			{
+				Name:        "tags",
+				Description: "The tags applied to the job queue.",
+				Type:        schema.TypeJSON,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchBatchJobQueues(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Batch
+	input := batch.DescribeJobQueuesInput{}
+	for {
+		output, err := svc.DescribeJobQueues(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.JobQueues
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
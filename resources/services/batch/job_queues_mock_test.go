@@ -0,0 +1,31 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildBatchJobQueues(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockBatchClient(ctrl)
+
+	var queue types.JobQueueDetail
+	if err := faker.FakeData(&queue); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeJobQueues(gomock.Any(), &batch.DescribeJobQueuesInput{}, gomock.Any()).Return(
+		&batch.DescribeJobQueuesOutput{JobQueues: []types.JobQueueDetail{queue}},
+		nil,
+	)
+
+	return client.Services{Batch: m}
+}
+
+func TestBatchJobQueues(t *testing.T) {
+	client.AwsMockTestHelper(t, JobQueues(), buildBatchJobQueues, client.TestOptions{})
+}
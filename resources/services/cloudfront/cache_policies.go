@@ -16,7 +16,7 @@ func CloudfrontCachePolicies() *schema.Table {
 		Name:         "aws_cloudfront_cache_policies",
 		Description:  "Contains a cache policy.",
 		Resolver:     fetchCloudfrontCachePolicies,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("cloudfront"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
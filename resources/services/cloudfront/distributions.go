@@ -16,7 +16,7 @@ func CloudfrontDistributions() *schema.Table {
 		Name:         "aws_cloudfront_distributions",
 		Description:  "A summary of the information about a CloudFront distribution.",
 		Resolver:     fetchCloudfrontDistributions,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("cloudfront"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
@@ -0,0 +1,101 @@
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func CloudfrontFunctions() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_cloudfront_functions",
+		Description:  "Contains configuration information and metadata about a CloudFront function.",
+		Resolver:     fetchCloudfrontFunctions,
+		Multiplex:    client.ServiceAccountMultiplexer("cloudfront"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn", "stage"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the function.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("FunctionMetadata.FunctionARN"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the CloudFront function.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the CloudFront function.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "stage",
+				Description: "The stage that the function is in, either DEVELOPMENT or LIVE.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("FunctionMetadata.Stage"),
+			},
+			{
+				Name:        "comment",
+				Description: "A comment to describe the function.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("FunctionConfig.Comment"),
+			},
+			{
+				Name:        "runtime",
+				Description: "The function's runtime environment.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("FunctionConfig.Runtime"),
+			},
+			{
+				Name:        "created_time",
+				Description: "The date and time when the function was created.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("FunctionMetadata.CreatedTime"),
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "The date and time when the function was most recently updated.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("FunctionMetadata.LastModifiedTime"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCloudfrontFunctions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	var config cloudfront.ListFunctionsInput
+	svc := meta.(*client.Client).Services().Cloudfront
+	for {
+		response, err := svc.ListFunctions(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		if response.FunctionList == nil {
+			break
+		}
+		res <- response.FunctionList.Items
+		if aws.ToString(response.FunctionList.NextMarker) == "" {
+			break
+		}
+		config.Marker = response.FunctionList.NextMarker
+	}
+	return nil
+}
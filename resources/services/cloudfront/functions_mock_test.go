@@ -0,0 +1,37 @@
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	cloudfrontTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildCloudfrontFunctionsMock(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockCloudfrontClient(ctrl)
+	services := client.Services{
+		Cloudfront: m,
+	}
+	f := cloudfrontTypes.FunctionSummary{}
+	if err := faker.FakeData(&f); err != nil {
+		t.Fatal(err)
+	}
+
+	m.EXPECT().ListFunctions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudfront.ListFunctionsOutput{
+			FunctionList: &cloudfrontTypes.FunctionList{
+				Items: []cloudfrontTypes.FunctionSummary{f},
+			},
+		},
+		nil,
+	)
+	return services
+}
+
+func TestCloudfrontFunctions(t *testing.T) {
+	client.AwsMockTestHelper(t, CloudfrontFunctions(), buildCloudfrontFunctionsMock, client.TestOptions{})
+}
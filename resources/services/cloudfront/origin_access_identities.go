@@ -0,0 +1,79 @@
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func CloudfrontOriginAccessIdentities() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_cloudfront_origin_access_identities",
+		Description:  "Summary of the information about a CloudFront origin access identity.",
+		Resolver:     fetchCloudfrontOriginAccessIdentities,
+		Multiplex:    client.ServiceAccountMultiplexer("cloudfront"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the resource.",
+				Type:        schema.TypeString,
+				Resolver: client.ResolveARNWithAccount(client.CloudfrontService, func(resource *schema.Resource) ([]string, error) {
+					return []string{"origin-access-identity", *resource.Item.(types.CloudFrontOriginAccessIdentitySummary).Id}, nil
+				}),
+			},
+			{
+				Name:        "id",
+				Description: "The ID for the origin access identity.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "comment",
+				Description: "The comment for this origin access identity, as originally specified when created.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "s3_canonical_user_id",
+				Description: "The Amazon S3 canonical user ID for the origin access identity, which you use when giving the origin access identity read permission to an object in Amazon S3.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchCloudfrontOriginAccessIdentities(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	var config cloudfront.ListCloudFrontOriginAccessIdentitiesInput
+	svc := meta.(*client.Client).Services().Cloudfront
+	for {
+		response, err := svc.ListCloudFrontOriginAccessIdentities(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		if response.CloudFrontOriginAccessIdentityList == nil {
+			break
+		}
+		res <- response.CloudFrontOriginAccessIdentityList.Items
+		if aws.ToString(response.CloudFrontOriginAccessIdentityList.NextMarker) == "" {
+			break
+		}
+		config.Marker = response.CloudFrontOriginAccessIdentityList.NextMarker
+	}
+	return nil
+}
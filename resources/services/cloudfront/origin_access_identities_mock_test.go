@@ -0,0 +1,37 @@
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	cloudfrontTypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildCloudfrontOriginAccessIdentitiesMock(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockCloudfrontClient(ctrl)
+	services := client.Services{
+		Cloudfront: m,
+	}
+	oai := cloudfrontTypes.CloudFrontOriginAccessIdentitySummary{}
+	if err := faker.FakeData(&oai); err != nil {
+		t.Fatal(err)
+	}
+
+	m.EXPECT().ListCloudFrontOriginAccessIdentities(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudfront.ListCloudFrontOriginAccessIdentitiesOutput{
+			CloudFrontOriginAccessIdentityList: &cloudfrontTypes.CloudFrontOriginAccessIdentityList{
+				Items: []cloudfrontTypes.CloudFrontOriginAccessIdentitySummary{oai},
+			},
+		},
+		nil,
+	)
+	return services
+}
+
+func TestCloudfrontOriginAccessIdentities(t *testing.T) {
+	client.AwsMockTestHelper(t, CloudfrontOriginAccessIdentities(), buildCloudfrontOriginAccessIdentitiesMock, client.TestOptions{})
+}
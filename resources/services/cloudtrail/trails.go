@@ -2,6 +2,7 @@ package cloudtrail
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 
@@ -17,6 +18,9 @@ import (
 type CloudTrailWrapper struct {
 	types.Trail
 	Tags map[string]string
+
+	// eventSelectors are set by the advanced_event_selectors resolver and reused by the event selectors relation
+	eventSelectors []types.EventSelector
 }
 
 // groupNameRegex extracts log group name from the ARN
@@ -120,6 +124,12 @@ func CloudtrailTrails() *schema.Table {
 				Description: "Specifies if the trail has custom event selectors.",
 				Type:        schema.TypeBool,
 			},
+			{
+				Name:        "advanced_event_selectors",
+				Description: "The advanced event selectors of the trail, set only if the trail uses advanced event selectors instead of event selectors.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveCloudtrailTrailAdvancedEventSelectors,
+			},
 			{
 				Name:        "has_insight_selectors",
 				Description: "Specifies whether a trail has insight types specified in an InsightSelector list.",
@@ -223,6 +233,12 @@ func CloudtrailTrails() *schema.Table {
 						Description: "Specify if you want your trail to log read-only events, write-only events, or all",
 						Type:        schema.TypeString,
 					},
+					{
+						Name:        "data_resources",
+						Description: "The Amazon S3 buckets, Lambda functions, or DynamoDB tables that you specify in your event selectors for your trail to log data events",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveCloudtrailTrailEventSelectorDataResources,
+					},
 				},
 			},
 		},
@@ -382,16 +398,42 @@ func resolveCloudtrailTrailCloudwatchLogsLogGroupName(ctx context.Context, meta
 
 func fetchCloudtrailTrailEventSelectors(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	r := parent.Item.(CloudTrailWrapper)
-	c := meta.(*client.Client)
-	svc := c.Services().Cloudtrail
+	res <- r.eventSelectors
+	return nil
+}
+
+func resolveCloudtrailTrailEventSelectorDataResources(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	selector := resource.Item.(types.EventSelector)
+	if len(selector.DataResources) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(selector.DataResources)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+
+func resolveCloudtrailTrailAdvancedEventSelectors(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(CloudTrailWrapper)
+	svc := meta.(*client.Client).Services().Cloudtrail
 	response, err := svc.GetEventSelectors(ctx, &cloudtrail.GetEventSelectorsInput{TrailName: r.TrailARN}, func(options *cloudtrail.Options) {
 		options.Region = *r.HomeRegion
 	})
 	if err != nil {
 		return diag.WrapError(err)
 	}
-	res <- response.EventSelectors
-	return nil
+	// relations are fetched after the trail's columns are resolved, so the selectors are only requested once per trail
+	r.eventSelectors = response.EventSelectors
+	resource.Item = r
+	if len(response.AdvancedEventSelectors) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(response.AdvancedEventSelectors)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
 }
 
 func getCloudTrailTagsByResourceID(id string, set []types.ResourceTag) []types.Tag {
@@ -24,6 +24,7 @@ func buildCloudtrailTrailsMock(t *testing.T, ctrl *gomock.Controller) client.Ser
 	}
 
 	trail.TrailARN = aws.String("arn:aws:cloudtrail:eu-central-1:testAccount:trail/test-trail")
+	trail.HasCustomEventSelectors = aws.Bool(true)
 
 	trailStatus := cloudtrail.GetTrailStatusOutput{}
 	err = faker.FakeData(&trailStatus)
@@ -45,9 +46,15 @@ func buildCloudtrailTrailsMock(t *testing.T, ctrl *gomock.Controller) client.Ser
 		&trailStatus,
 		nil,
 	)
+	advancedEventSelector := cloudtrailTypes.AdvancedEventSelector{}
+	err = faker.FakeData(&advancedEventSelector)
+	if err != nil {
+		t.Fatal(err)
+	}
 	m.EXPECT().GetEventSelectors(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&cloudtrail.GetEventSelectorsOutput{
-			EventSelectors: []cloudtrailTypes.EventSelector{eventSelector},
+			EventSelectors:         []cloudtrailTypes.EventSelector{eventSelector},
+			AdvancedEventSelectors: []cloudtrailTypes.AdvancedEventSelector{advancedEventSelector},
 		},
 		nil,
 	)
@@ -0,0 +1,221 @@
+package connect
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+type storageConfigWrapper struct {
+	types.InstanceStorageConfig
+	ResourceType types.InstanceStorageResourceType
+}
+
+func Instances() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_connect_instances",
+		Description:  "The Amazon Connect instance.",
+		Resolver:     fetchConnectInstances,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("connect"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "id",
+				Description: "The identifier of the Amazon Connect instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "alias",
+				Description: "The alias of instance.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InstanceAlias"),
+			},
+			{
+				Name:        "created_time",
+				Description: "When the instance was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "identity_management_type",
+				Description: "The identity management type.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "inbound_calls_enabled",
+				Description: "Whether inbound calls are enabled.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "outbound_calls_enabled",
+				Description: "Whether outbound calls are enabled.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "status",
+				Description: "The state of the instance.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InstanceStatus"),
+			},
+			{
+				Name:        "status_reason",
+				Description: "The status message of the instance, when it failed to be created.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("StatusReason.Message"),
+			},
+			{
+				Name:        "service_role",
+				Description: "The service role of the instance.",
+				Type:        schema.TypeString,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_connect_instance_storage_configs",
+				Description: "The storage configuration for the instance.",
+				Resolver:    fetchConnectInstanceStorageConfigs,
+				Columns: []schema.Column{
+					{
+						Name:        "instance_cq_id",
+						Description: "Unique CloudQuery ID of aws_connect_instances table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "resource_type",
+						Description: "The type of data the storage config is used for, such as CONTACT_TRACE_RECORDS or AGENT_EVENTS.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "association_id",
+						Description: "The existing association identifier that uniquely identifies the resource type and storage config for the given instance ID.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "storage_type",
+						Description: "A valid storage type.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "kinesis_stream_arn",
+						Description: "The Amazon Resource Name (ARN) of the data stream.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("KinesisStreamConfig.StreamArn"),
+					},
+					{
+						Name:        "kinesis_firehose_arn",
+						Description: "The Amazon Resource Name (ARN) of the delivery stream.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("KinesisFirehoseConfig.FirehoseArn"),
+					},
+					{
+						Name:        "kinesis_video_stream_prefix",
+						Description: "The prefix of the video stream.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("KinesisVideoStreamConfig.Prefix"),
+					},
+					{
+						Name:        "s3_bucket_name",
+						Description: "The S3 bucket name.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("S3Config.BucketName"),
+					},
+					{
+						Name:        "s3_bucket_prefix",
+						Description: "The S3 bucket prefix.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("S3Config.BucketPrefix"),
+					},
+					{
+						Name:        "target_table",
+						Description: "The table holding the stream the data is sent to: aws_kinesis_streams or aws_firehose_delivery_streams",
+						Type:        schema.TypeString,
+						Resolver:    resolveConnectInstanceStorageConfigTargetTable,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchConnectInstances(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Connect
+	paginator := connect.NewListInstancesPaginator(svc, &connect.ListInstancesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for _, summary := range output.InstanceSummaryList {
+			instance, err := svc.DescribeInstance(ctx, &connect.DescribeInstanceInput{InstanceId: summary.Id})
+			if err != nil {
+				return diag.WrapError(err)
+			}
+			res <- instance.Instance
+		}
+	}
+	return nil
+}
+func fetchConnectInstanceStorageConfigs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().Connect
+	instance := parent.Item.(*types.Instance)
+	for _, resourceType := range types.InstanceStorageResourceType("").Values() {
+		paginator := connect.NewListInstanceStorageConfigsPaginator(svc, &connect.ListInstanceStorageConfigsInput{
+			InstanceId:   instance.Id,
+			ResourceType: resourceType,
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				// a resource type that isn't supported or allowed for the instance shouldn't drop the configs of the other types
+				if client.IsAWSError(err, "InvalidParameter", "InvalidRequest", "ResourceNotFound") || client.IgnoreAccessDeniedServiceDisabled(err) {
+					c.Logger().Debug("skipping instance storage configs", "instance", aws.ToString(instance.Id), "resource_type", resourceType, "err", err)
+					break
+				}
+				return diag.WrapError(err)
+			}
+			for _, config := range output.StorageConfigs {
+				res <- storageConfigWrapper{InstanceStorageConfig: config, ResourceType: resourceType}
+			}
+		}
+	}
+	return nil
+}
+func resolveConnectInstanceStorageConfigTargetTable(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	config := resource.Item.(storageConfigWrapper)
+	switch config.StorageType {
+	case types.StorageTypeKinesisStream:
+		return diag.WrapError(resource.Set(c.Name, "aws_kinesis_streams"))
+	case types.StorageTypeKinesisFirehose:
+		return diag.WrapError(resource.Set(c.Name, "aws_firehose_delivery_streams"))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package connect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/connect"
+	"github.com/aws/aws-sdk-go-v2/service/connect/types"
+	"github.com/aws/smithy-go"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildConnectInstancesMock(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockConnectClient(ctrl)
+
+	summary := types.InstanceSummary{}
+	if err := faker.FakeData(&summary); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListInstances(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&connect.ListInstancesOutput{InstanceSummaryList: []types.InstanceSummary{summary}}, nil)
+
+	instance := types.Instance{}
+	if err := faker.FakeData(&instance); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeInstance(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&connect.DescribeInstanceOutput{Instance: &instance}, nil)
+
+	config := types.InstanceStorageConfig{}
+	if err := faker.FakeData(&config); err != nil {
+		t.Fatal(err)
+	}
+	config.StorageType = types.StorageTypeKinesisStream
+	m.EXPECT().ListInstanceStorageConfigs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&connect.ListInstanceStorageConfigsOutput{StorageConfigs: []types.InstanceStorageConfig{config}}, nil).AnyTimes()
+
+	return client.Services{Connect: m}
+}
+
+func TestConnectInstances(t *testing.T) {
+	client.AwsMockTestHelper(t, Instances(), buildConnectInstancesMock, client.TestOptions{})
+}
+
+func TestFetchConnectInstanceStorageConfigsSkipsFailingType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mocks.NewMockConnectClient(ctrl)
+	m.EXPECT().ListInstanceStorageConfigs(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *connect.ListInstanceStorageConfigsInput, _ ...func(*connect.Options)) (*connect.ListInstanceStorageConfigsOutput, error) {
+			if input.ResourceType == types.InstanceStorageResourceTypeChatTranscripts {
+				return nil, &smithy.GenericAPIError{Code: "InvalidParameterException", Message: "resource type not supported"}
+			}
+			return &connect.ListInstanceStorageConfigsOutput{StorageConfigs: []types.InstanceStorageConfig{{StorageType: types.StorageTypeKinesisStream}}}, nil
+		}).Times(len(types.InstanceStorageResourceType("").Values()))
+
+	c := client.NewAwsClient(hclog.NewNullLogger())
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "testAccount", "us-east-1", client.Services{Connect: m})
+	c.Partition, c.AccountID, c.Region = "aws", "testAccount", "us-east-1"
+
+	parent := schema.NewResourceData(schema.PostgresDialect{}, &schema.Table{}, nil, &types.Instance{Id: aws.String("instance")}, nil, time.Now())
+	res := make(chan interface{}, 20)
+	assert.NoError(t, fetchConnectInstanceStorageConfigs(context.Background(), &c, parent, res))
+	close(res)
+	var resourceTypes []types.InstanceStorageResourceType
+	for r := range res {
+		resourceTypes = append(resourceTypes, r.(storageConfigWrapper).ResourceType)
+	}
+	assert.Len(t, resourceTypes, len(types.InstanceStorageResourceType("").Values())-1)
+	assert.NotContains(t, resourceTypes, types.InstanceStorageResourceTypeChatTranscripts)
+}
@@ -0,0 +1,258 @@
+package dms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+type DmsReplicationTaskWrapper struct {
+	types.ReplicationTask
+	Tags                   map[string]interface{}
+	TargetKinesisStreamArn *string
+}
+
+func DmsReplicationTasks() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_dms_replication_tasks",
+		Description:  "Provides information that describes a replication task created by the CreateReplicationTask operation.",
+		Resolver:     fetchDmsReplicationTasks,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("dms"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "tags",
+				Description: "Any tags assigned to the resource",
+				Type:        schema.TypeJSON,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the replication task.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ReplicationTaskArn"),
+			},
+			{
+				Name:        "identifier",
+				Description: "The user-assigned replication task identifier or name.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ReplicationTaskIdentifier"),
+			},
+			{
+				Name:        "replication_instance_arn",
+				Description: "The ARN of the replication instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "source_endpoint_arn",
+				Description: "The Amazon Resource Name (ARN) that uniquely identifies the source endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "target_endpoint_arn",
+				Description: "The ARN that uniquely identifies the target endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "target_kinesis_stream_arn",
+				Description: "The ARN of the Kinesis data stream the task writes to, when the target endpoint is Kinesis.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "migration_type",
+				Description: "The type of migration.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "The status of the replication task.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "stop_reason",
+				Description: "The reason the replication task was stopped.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "last_failure_message",
+				Description: "The last error (failure) message generated for the replication task.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "table_mappings",
+				Description: "Table mappings specified in the task.",
+				Type:        schema.TypeJSON,
+			},
+			{
+				Name:        "settings",
+				Description: "The settings for the replication task.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ReplicationTaskSettings"),
+			},
+			{
+				Name:        "task_data",
+				Description: "Supplemental information that the task requires to migrate the data for certain source and target endpoints.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "cdc_start_position",
+				Description: "Indicates when you want a change data capture (CDC) operation to start.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "cdc_stop_position",
+				Description: "Indicates when you want a change data capture (CDC) operation to stop.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "recovery_checkpoint",
+				Description: "Indicates the last checkpoint that occurred during a change data capture (CDC) operation.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date the replication task was created.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("ReplicationTaskCreationDate"),
+			},
+			{
+				Name:        "start_date",
+				Description: "The date the replication task is scheduled to start.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("ReplicationTaskStartDate"),
+			},
+			{
+				Name:        "target_replication_instance_arn",
+				Description: "The ARN of the replication instance to which this task is moved in response to running the MoveReplicationTask operation.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "stats_elapsed_time_millis",
+				Description: "The elapsed time of the task, in milliseconds.",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("ReplicationTaskStats.ElapsedTimeMillis"),
+			},
+			{
+				Name:        "stats_full_load_progress_percent",
+				Description: "The percent complete for the full load migration task.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("ReplicationTaskStats.FullLoadProgressPercent"),
+			},
+			{
+				Name:        "stats_tables_errored",
+				Description: "The number of errors that have occurred during this task.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("ReplicationTaskStats.TablesErrored"),
+			},
+			{
+				Name:        "stats_tables_loaded",
+				Description: "The number of tables loaded for this task.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("ReplicationTaskStats.TablesLoaded"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchDmsReplicationTasks(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().DMS
+
+	var tasks []types.ReplicationTask
+	input := databasemigrationservice.DescribeReplicationTasksInput{}
+	for {
+		output, err := svc.DescribeReplicationTasks(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		tasks = append(tasks, output.ReplicationTasks...)
+		if aws.ToString(output.Marker) == "" {
+			break
+		}
+		input.Marker = output.Marker
+	}
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	kinesisStreams, err := kinesisEndpointStreams(ctx, svc)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+
+	listTagsForResourceInput := databasemigrationservice.ListTagsForResourceInput{}
+	for _, task := range tasks {
+		listTagsForResourceInput.ResourceArnList = append(listTagsForResourceInput.ResourceArnList, *task.ReplicationTaskArn)
+	}
+	listTagsForResourceOutput, err := svc.ListTagsForResource(ctx, &listTagsForResourceInput)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	taskTags := make(map[string]map[string]interface{})
+	for _, tag := range listTagsForResourceOutput.TagList {
+		if taskTags[*tag.ResourceArn] == nil {
+			taskTags[*tag.ResourceArn] = make(map[string]interface{})
+		}
+		taskTags[*tag.ResourceArn][*tag.Key] = *tag.Value
+	}
+
+	for _, task := range tasks {
+		res <- DmsReplicationTaskWrapper{
+			ReplicationTask:        task,
+			Tags:                   taskTags[*task.ReplicationTaskArn],
+			TargetKinesisStreamArn: kinesisStreams[aws.ToString(task.TargetEndpointArn)],
+		}
+	}
+	return nil
+}
+
+// ====================================================================================================================
+//                                                  User Defined Helpers
+// ====================================================================================================================
+
+// kinesisEndpointStreams maps the ARN of every Kinesis target endpoint to the ARN of the stream it writes to
+func kinesisEndpointStreams(ctx context.Context, svc client.DatabasemigrationserviceClient) (map[string]*string, error) {
+	streams := make(map[string]*string)
+	input := databasemigrationservice.DescribeEndpointsInput{
+		Filters: []types.Filter{
+			{Name: aws.String("endpoint-type"), Values: []string{"target"}},
+			{Name: aws.String("engine-name"), Values: []string{"kinesis"}},
+		},
+	}
+	for {
+		output, err := svc.DescribeEndpoints(ctx, &input)
+		if err != nil {
+			return nil, err
+		}
+		for _, endpoint := range output.Endpoints {
+			if endpoint.KinesisSettings != nil {
+				streams[aws.ToString(endpoint.EndpointArn)] = endpoint.KinesisSettings.StreamArn
+			}
+		}
+		if aws.ToString(output.Marker) == "" {
+			return streams, nil
+		}
+		input.Marker = output.Marker
+	}
+}
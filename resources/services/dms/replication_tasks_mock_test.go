@@ -0,0 +1,54 @@
+package dms
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice"
+	"github.com/aws/aws-sdk-go-v2/service/databasemigrationservice/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildDmsReplicationTasks(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockDatabasemigrationserviceClient(ctrl)
+	task := types.ReplicationTask{}
+	if err := faker.FakeData(&task); err != nil {
+		t.Fatal(err)
+	}
+	task.TableMappings = aws.String(`{"rules":[]}`)
+	task.ReplicationTaskSettings = aws.String(`{"Logging":{"EnableLogging":true}}`)
+	m.EXPECT().DescribeReplicationTasks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&databasemigrationservice.DescribeReplicationTasksOutput{
+			ReplicationTasks: []types.ReplicationTask{task},
+		}, nil)
+
+	endpoint := types.Endpoint{}
+	if err := faker.FakeData(&endpoint); err != nil {
+		t.Fatal(err)
+	}
+	endpoint.EndpointArn = task.TargetEndpointArn
+	m.EXPECT().DescribeEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&databasemigrationservice.DescribeEndpointsOutput{
+			Endpoints: []types.Endpoint{endpoint},
+		}, nil)
+
+	tag := types.Tag{}
+	if err := faker.FakeData(&tag); err != nil {
+		t.Fatal(err)
+	}
+	tag.ResourceArn = task.ReplicationTaskArn
+	m.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&databasemigrationservice.ListTagsForResourceOutput{
+			TagList: []types.Tag{tag},
+		}, nil)
+	return client.Services{
+		DMS: m,
+	}
+}
+
+func TestDmsReplicationTasks(t *testing.T) {
+	client.AwsMockTestHelper(t, DmsReplicationTasks(), buildDmsReplicationTasks, client.TestOptions{})
+}
@@ -0,0 +1,139 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2InstanceConnectEndpoints() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_instance_connect_endpoints",
+		Description:  "The EC2 Instance Connect Endpoint.",
+		Resolver:     fetchEc2InstanceConnectEndpoints,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("ec2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InstanceConnectEndpointArn"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("InstanceConnectEndpointId"),
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time that the EC2 Instance Connect Endpoint was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "dns_name",
+				Description: "The DNS name of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "fips_dns_name",
+				Description: "The FIPS DNS name of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "network_interface_ids",
+				Description: "The IDs of the elastic network interfaces that Amazon EC2 automatically created when creating the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeStringArray,
+			},
+			{
+				Name:        "owner_id",
+				Description: "The ID of the AWS account that created the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "preserve_client_ip",
+				Description: "Indicates whether your client's IP address is preserved as the source.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "security_group_ids",
+				Description: "The security groups associated with the endpoint.",
+				Type:        schema.TypeStringArray,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "state_message",
+				Description: "The message for the current state of the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "subnet_id",
+				Description: "The ID of the subnet in which the EC2 Instance Connect Endpoint was created.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "vpc_id",
+				Description: "The ID of the VPC in which the EC2 Instance Connect Endpoint was created.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the EC2 Instance Connect Endpoint.",
+				Type:        schema.TypeJSON,
+				Resolver:    client.ResolveTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchEc2InstanceConnectEndpoints(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	var config ec2.DescribeInstanceConnectEndpointsInput
+	for {
+		output, err := svc.DescribeInstanceConnectEndpoints(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.InstanceConnectEndpoints
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2InstanceConnectEndpoints(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+	l := ec2Types.Ec2InstanceConnectEndpoint{}
+	err := faker.FakeData(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeInstanceConnectEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeInstanceConnectEndpointsOutput{
+			InstanceConnectEndpoints: []ec2Types.Ec2InstanceConnectEndpoint{l},
+		}, nil)
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2InstanceConnectEndpoints(t *testing.T) {
+	client.AwsMockTestHelper(t, Ec2InstanceConnectEndpoints(), buildEc2InstanceConnectEndpoints, client.TestOptions{})
+}
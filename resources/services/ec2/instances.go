@@ -217,6 +217,12 @@ func Ec2Instances() *schema.Table {
 				Type:        schema.TypeString,
 				Resolver:    schema.PathResolver("MetadataOptions.HttpTokens"),
 			},
+			{
+				Name:        "metadata_options_instance_metadata_tags",
+				Description: "Indicates whether access to instance tags from the instance metadata is enabled or disabled",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("MetadataOptions.InstanceMetadataTags"),
+			},
 			{
 				Name:        "metadata_options_state",
 				Description: "The state of the metadata option changes",
@@ -0,0 +1,182 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2LaunchTemplates() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_launch_templates",
+		Description:  "Describes a launch template.",
+		Resolver:     fetchEc2LaunchTemplates,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("ec2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the launch template.",
+				Type:        schema.TypeString,
+				Resolver: client.ResolveARN(client.EC2Service, func(resource *schema.Resource) ([]string, error) {
+					return []string{"launch-template", *resource.Item.(types.LaunchTemplate).LaunchTemplateId}, nil
+				}),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the launch template.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("LaunchTemplateId"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the launch template.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("LaunchTemplateName"),
+			},
+			{
+				Name:        "create_time",
+				Description: "The time launch template was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "created_by",
+				Description: "The principal that created the launch template.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "default_version_number",
+				Description: "The version number of the default version of the launch template.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "latest_version_number",
+				Description: "The version number of the latest version of the launch template.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags for the launch template.",
+				Type:        schema.TypeJSON,
+				Resolver:    client.ResolveTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_ec2_launch_template_versions",
+				Description: "Describes a launch template version.",
+				Resolver:    fetchEc2LaunchTemplateVersions,
+				Columns: []schema.Column{
+					{
+						Name:        "launch_template_cq_id",
+						Description: "Unique CloudQuery ID of aws_ec2_launch_templates table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "version_number",
+						Description: "The version number.",
+						Type:        schema.TypeBigInt,
+					},
+					{
+						Name:        "version_description",
+						Description: "The description for the version.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "default_version",
+						Description: "Indicates whether the version is the default version.",
+						Type:        schema.TypeBool,
+					},
+					{
+						Name:        "create_time",
+						Description: "The time the version was created.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "created_by",
+						Description: "The principal that created the version.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "launch_template_data",
+						Description: "Information about the launch template, such as the AMI, instance type, security groups, user data and instance metadata options.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("LaunchTemplateData"),
+					},
+					{
+						Name:        "metadata_options_http_tokens",
+						Description: "The state of token usage for instance metadata requests. If the state is required, IMDSv2 is enforced.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("LaunchTemplateData.MetadataOptions.HttpTokens"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchEc2LaunchTemplates(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	var config ec2.DescribeLaunchTemplatesInput
+	for {
+		output, err := svc.DescribeLaunchTemplates(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.LaunchTemplates
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func fetchEc2LaunchTemplateVersions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	r := parent.Item.(types.LaunchTemplate)
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	config := ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: r.LaunchTemplateId,
+	}
+	for {
+		output, err := svc.DescribeLaunchTemplateVersions(ctx, &config, func(options *ec2.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.LaunchTemplateVersions
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2Types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEc2LaunchTemplates(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEc2Client(ctrl)
+	lt := ec2Types.LaunchTemplate{}
+	if err := faker.FakeData(&lt); err != nil {
+		t.Fatal(err)
+	}
+	ltv := ec2Types.LaunchTemplateVersion{}
+	if err := faker.FakeData(&ltv); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeLaunchTemplates(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeLaunchTemplatesOutput{
+			LaunchTemplates: []ec2Types.LaunchTemplate{lt},
+		}, nil)
+	m.EXPECT().DescribeLaunchTemplateVersions(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []ec2Types.LaunchTemplateVersion{ltv},
+		}, nil)
+	return client.Services{
+		EC2: m,
+	}
+}
+
+func TestEc2LaunchTemplates(t *testing.T) {
+	client.AwsMockTestHelper(t, Ec2LaunchTemplates(), buildEc2LaunchTemplates, client.TestOptions{})
+}
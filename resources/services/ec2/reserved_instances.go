@@ -0,0 +1,154 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2ReservedInstances() *schema.Table {
+	return &schema.Table{
+		Name:          "aws_ec2_reserved_instances",
+		Description:   "Describes a Reserved Instance.",
+		Resolver:      fetchEc2ReservedInstances,
+		Multiplex:     client.ServiceAccountRegionMultiplexer("ec2"),
+		IgnoreError:   client.IgnoreCommonErrors,
+		DeleteFilter:  client.DeleteAccountRegionFilter,
+		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Reserved Instance.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ReservedInstancesId"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the Reserved Instance.",
+				Type:        schema.TypeString,
+				Resolver: client.ResolveARN(client.EC2Service, func(resource *schema.Resource) ([]string, error) {
+					return []string{"reserved-instances", *resource.Item.(types.ReservedInstances).ReservedInstancesId}, nil
+				}),
+			},
+			{
+				Name:        "availability_zone",
+				Description: "The Availability Zone in which the Reserved Instance can be used.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "currency_code",
+				Description: "The currency of the Reserved Instance. It's specified using ISO 4217 standard currency codes.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "duration",
+				Description: "The duration of the Reserved Instance, in seconds.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "end",
+				Description: "The time when the Reserved Instance expires.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "fixed_price",
+				Description: "The purchase price of the Reserved Instance.",
+				Type:        schema.TypeFloat,
+			},
+			{
+				Name:        "instance_count",
+				Description: "The number of reservations purchased.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "instance_tenancy",
+				Description: "The tenancy of the instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "instance_type",
+				Description: "The instance type on which the Reserved Instance can be used.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "offering_class",
+				Description: "The offering class of the Reserved Instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "offering_type",
+				Description: "The Reserved Instance offering type.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "product_description",
+				Description: "The Reserved Instance product platform description.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "recurring_charges",
+				Description: "The recurring charge tag assigned to the resource.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("RecurringCharges"),
+			},
+			{
+				Name:        "scope",
+				Description: "The scope of the Reserved Instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "start",
+				Description: "The date and time the Reserved Instance started.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "state",
+				Description: "The state of the Reserved Instance purchase.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "usage_price",
+				Description: "The usage price of the Reserved Instance, per hour.",
+				Type:        schema.TypeFloat,
+			},
+			{
+				Name:        "tags",
+				Description: "Any tags assigned to the resource.",
+				Type:        schema.TypeJSON,
+				Resolver:    client.ResolveTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2ReservedInstances(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	response, err := svc.DescribeReservedInstances(ctx, &ec2.DescribeReservedInstancesInput{}, func(options *ec2.Options) {
+		options.Region = c.Region
+	})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	res <- response.ReservedInstances
+	return nil
+}
@@ -2,6 +2,7 @@ package ec2
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -74,6 +75,12 @@ func Ec2VpcEndpoints() *schema.Table {
 				Description: "The policy document associated with the endpoint, if applicable.",
 				Type:        schema.TypeString,
 			},
+			{
+				Name:        "policy_document_json",
+				Description: "The policy document associated with the endpoint, if applicable, parsed as JSON.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEc2VpcEndpointPolicyDocumentJson,
+			},
 			{
 				Name:        "private_dns_enabled",
 				Description: "(Interface endpoint) Indicates whether the VPC is associated with a private hosted zone.",
@@ -202,3 +209,14 @@ func fetchEc2VpcEndpoints(ctx context.Context, meta schema.ClientMeta, parent *s
 	}
 	return nil
 }
+func resolveEc2VpcEndpointPolicyDocumentJson(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	e := resource.Item.(types.VpcEndpoint)
+	if e.PolicyDocument == nil {
+		return nil
+	}
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(*e.PolicyDocument), &document); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, document))
+}
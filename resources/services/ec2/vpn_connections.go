@@ -0,0 +1,175 @@
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Ec2VpnConnections() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ec2_vpn_connections",
+		Description:  "Describes a Site-to-Site VPN connection.",
+		Resolver:     fetchEc2VpnConnections,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("ec2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the VPN connection.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("VpnConnectionId"),
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) for the VPN connection.",
+				Type:        schema.TypeString,
+				Resolver: client.ResolveARN(client.EC2Service, func(resource *schema.Resource) ([]string, error) {
+					return []string{"vpn-connection", *resource.Item.(types.VpnConnection).VpnConnectionId}, nil
+				}),
+			},
+			{
+				Name:        "category",
+				Description: "The category of the VPN connection. A value of VPN indicates an Amazon Web Services VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "customer_gateway_id",
+				Description: "The ID of the customer gateway at your end of the VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "gateway_association_state",
+				Description: "The current state of the gateway association.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "transit_gateway_id",
+				Description: "The ID of the transit gateway associated with the VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "vpn_gateway_id",
+				Description: "The ID of the virtual private gateway at the Amazon Web Services side of the VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "The type of VPN connection.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "options_enable_acceleration",
+				Description: "Indicates whether acceleration is enabled for the VPN connection.",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("Options.EnableAcceleration"),
+			},
+			{
+				Name:        "options_static_routes_only",
+				Description: "Indicates whether the VPN connection uses static routes only.",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("Options.StaticRoutesOnly"),
+			},
+			{
+				Name:        "options_local_ipv4_network_cidr",
+				Description: "The IPv4 CIDR on the customer gateway (on-premises) side of the VPN connection.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Options.LocalIpv4NetworkCidr"),
+			},
+			{
+				Name:        "options_remote_ipv4_network_cidr",
+				Description: "The IPv4 CIDR on the Amazon Web Services side of the VPN connection.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Options.RemoteIpv4NetworkCidr"),
+			},
+			{
+				Name:        "options_tunnel_inside_ip_version",
+				Description: "Indicates whether the VPN tunnels process IPv4 or IPv6 traffic.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Options.TunnelInsideIpVersion"),
+			},
+			{
+				Name:        "options_tunnel_options",
+				Description: "The options for the VPN tunnels. Pre-shared keys are not stored.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEc2VpnConnectionTunnelOptions,
+			},
+			{
+				Name:        "routes",
+				Description: "The static routes associated with the VPN connection.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("Routes"),
+			},
+			{
+				Name:        "vgw_telemetry",
+				Description: "Information about the VPN tunnel, including its status.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("VgwTelemetry"),
+			},
+			{
+				Name:        "tags",
+				Description: "Any tags assigned to the VPN connection.",
+				Type:        schema.TypeJSON,
+				Resolver:    client.ResolveTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+func fetchEc2VpnConnections(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().EC2
+	response, err := svc.DescribeVpnConnections(ctx, &ec2.DescribeVpnConnectionsInput{}, func(options *ec2.Options) {
+		options.Region = c.Region
+	})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	res <- response.VpnConnections
+	return nil
+}
+
+func resolveEc2VpnConnectionTunnelOptions(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	conn := resource.Item.(types.VpnConnection)
+	if conn.Options == nil {
+		return nil
+	}
+	tunnels := make([]types.TunnelOption, len(conn.Options.TunnelOptions))
+	for i, t := range conn.Options.TunnelOptions {
+		// never persist the tunnel pre-shared keys
+		t.PreSharedKey = nil
+		tunnels[i] = t
+	}
+	b, err := json.Marshal(tunnels)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
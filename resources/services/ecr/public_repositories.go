@@ -0,0 +1,129 @@
+package ecr
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// ecrPublicRegion is the only region that serves the ECR Public control plane API.
+const ecrPublicRegion = "us-east-1"
+
+func PublicRepositories() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ecr_public_repositories",
+		Description:  "An object representing a public repository.",
+		Resolver:     fetchEcrPublicRepositories,
+		Multiplex:    client.ServiceAccountMultiplexer("api.ecr-public"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) that identifies the repository.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("RepositoryArn"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the repository.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("RepositoryName"),
+			},
+			{
+				Name:        "uri",
+				Description: "The URI for the repository.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("RepositoryUri"),
+			},
+			{
+				Name:        "registry_id",
+				Description: "The AWS account ID associated with the public registry that contains the repository.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time, in JavaScript date format, when the repository was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "catalog_data",
+				Description: "The catalog data for the repository, such as its description, architectures and operating systems. This data is publicly visible in the Amazon ECR Public Gallery.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEcrPublicRepositoryCatalogData,
+			},
+			{
+				Name:     "tags",
+				Type:     schema.TypeJSON,
+				Resolver: resolveEcrPublicRepositoryTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchEcrPublicRepositories(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	config := ecrpublic.DescribeRepositoriesInput{
+		MaxResults: aws.Int32(1000),
+	}
+	c := meta.(*client.Client)
+	svc := c.Services().ECRPublic
+	for {
+		output, err := svc.DescribeRepositories(ctx, &config, func(options *ecrpublic.Options) {
+			options.Region = ecrPublicRegion
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.Repositories
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func resolveEcrPublicRepositoryCatalogData(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	svc := meta.(*client.Client).Services().ECRPublic
+	repo := resource.Item.(types.Repository)
+
+	output, err := svc.GetRepositoryCatalogData(ctx, &ecrpublic.GetRepositoryCatalogDataInput{
+		RepositoryName: repo.RepositoryName,
+		RegistryId:     repo.RegistryId,
+	}, func(options *ecrpublic.Options) {
+		options.Region = ecrPublicRegion
+	})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, output.CatalogData))
+}
+func resolveEcrPublicRepositoryTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	svc := meta.(*client.Client).Services().ECRPublic
+	repo := resource.Item.(types.Repository)
+
+	output, err := svc.ListTagsForResource(ctx, &ecrpublic.ListTagsForResourceInput{
+		ResourceArn: repo.RepositoryArn,
+	}, func(options *ecrpublic.Options) {
+		options.Region = ecrPublicRegion
+	})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, client.TagsToMap(output.Tags)))
+}
@@ -0,0 +1,47 @@
+package ecr
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic"
+	"github.com/aws/aws-sdk-go-v2/service/ecrpublic/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildEcrPublicRepositoriesMock(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockEcrPublicClient(ctrl)
+	r := types.Repository{}
+	err := faker.FakeData(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeRepositories(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ecrpublic.DescribeRepositoriesOutput{
+			Repositories: []types.Repository{r},
+		}, nil)
+
+	catalog := ecrpublic.GetRepositoryCatalogDataOutput{}
+	err = faker.FakeData(&catalog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().GetRepositoryCatalogData(gomock.Any(), gomock.Any(), gomock.Any()).Return(&catalog, nil)
+
+	tagResponse := ecrpublic.ListTagsForResourceOutput{}
+	err = faker.FakeData(&tagResponse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(&tagResponse, nil)
+
+	return client.Services{
+		ECRPublic: m,
+	}
+}
+
+func TestEcrPublicRepositories(t *testing.T) {
+	client.AwsMockTestHelper(t, PublicRepositories(), buildEcrPublicRepositoriesMock, client.TestOptions{})
+}
@@ -2,6 +2,7 @@ package ecr
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
@@ -38,6 +39,18 @@ func Repositories() *schema.Table {
 				Type:     schema.TypeJSON,
 				Resolver: resolveEcrRepositoryTags,
 			},
+			{
+				Name:        "repository_policy",
+				Description: "The JSON repository policy text associated with the repository.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEcrRepositoryRepositoryPolicy,
+			},
+			{
+				Name:        "lifecycle_policy",
+				Description: "The JSON lifecycle policy text associated with the repository.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEcrRepositoryLifecyclePolicy,
+			},
 			{
 				Name:        "created_at",
 				Description: "The date and time, in JavaScript date format, when the repository was created.",
@@ -233,6 +246,54 @@ func resolveEcrRepositoryTags(ctx context.Context, meta schema.ClientMeta, resou
 	}
 	return diag.WrapError(resource.Set(c.Name, client.TagsToMap(output.Tags)))
 }
+func resolveEcrRepositoryRepositoryPolicy(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	svc := cl.Services().ECR
+	repo := resource.Item.(types.Repository)
+
+	output, err := svc.GetRepositoryPolicy(ctx, &ecr.GetRepositoryPolicyInput{
+		RepositoryName: repo.RepositoryName,
+		RegistryId:     repo.RegistryId,
+	})
+	if err != nil {
+		if client.IsAWSError(err, "RepositoryPolicyNotFoundException") {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if output.PolicyText == nil {
+		return nil
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(*output.PolicyText), &policy); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, policy))
+}
+func resolveEcrRepositoryLifecyclePolicy(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	svc := cl.Services().ECR
+	repo := resource.Item.(types.Repository)
+
+	output, err := svc.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RepositoryName: repo.RepositoryName,
+		RegistryId:     repo.RegistryId,
+	})
+	if err != nil {
+		if client.IsAWSError(err, "LifecyclePolicyNotFoundException") {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if output.LifecyclePolicyText == nil {
+		return nil
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(*output.LifecyclePolicyText), &policy); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, policy))
+}
 
 func fetchEcrRepositoryImages(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	maxResults := int32(1000)
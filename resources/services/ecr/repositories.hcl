@@ -52,6 +52,16 @@ resource "aws" "ecr" "repositories" {
     type              = "json"
     generate_resolver = true
   }
+  userDefinedColumn "repository_policy" {
+    description       = "The JSON repository policy text associated with the repository."
+    type              = "json"
+    generate_resolver = true
+  }
+  userDefinedColumn "lifecycle_policy" {
+    description       = "The JSON lifecycle policy text associated with the repository."
+    type              = "json"
+    generate_resolver = true
+  }
   user_relation "aws" "ecr" "images" {
     path = "github.com/aws/aws-sdk-go-v2/service/ecr/types.ImageDetail"
     userDefinedColumn "account_id" {
@@ -3,6 +3,7 @@ package ecr
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -41,6 +42,15 @@ func buildEcrRepositoriesMock(t *testing.T, ctrl *gomock.Controller) client.Serv
 	}
 	m.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(&tagResponse, nil)
 
+	m.EXPECT().GetRepositoryPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ecr.GetRepositoryPolicyOutput{
+			PolicyText: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+		}, nil)
+	m.EXPECT().GetLifecyclePolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ecr.GetLifecyclePolicyOutput{
+			LifecyclePolicyText: aws.String(`{"rules":[]}`),
+		}, nil)
+
 	return client.Services{
 		ECR: m,
 	}
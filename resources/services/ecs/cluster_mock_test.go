@@ -3,6 +3,7 @@ package ecs
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	ecsTypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -22,6 +23,7 @@ func buildEcsClusterMock(t *testing.T, ctrl *gomock.Controller) client.Services
 	if err != nil {
 		t.Fatal(err)
 	}
+	c.Settings = []ecsTypes.ClusterSetting{{Name: ecsTypes.ClusterSettingNameContainerInsights, Value: aws.String("enabled")}}
 	ecsOutput := &ecs.DescribeClustersOutput{
 		Clusters: []ecsTypes.Cluster{c},
 	}
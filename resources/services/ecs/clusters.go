@@ -135,6 +135,12 @@ func Clusters() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveClustersSettings,
 			},
+			{
+				Name:        "setting_container_insights",
+				Description: "The value of the containerInsights setting, which determines whether CloudWatch Container Insights is enabled for the cluster",
+				Type:        schema.TypeString,
+				Resolver:    resolveClustersSettingContainerInsights,
+			},
 			{
 				Name:        "statistics",
 				Description: "Additional information about your clusters that are separated by launch type. They include the following:  * runningEC2TasksCount  * RunningFargateTasksCount  * pendingEC2TasksCount  * pendingFargateTasksCount  * activeEC2ServiceCount  * activeFargateServiceCount  * drainingEC2ServiceCount  * drainingFargateServiceCount",
@@ -1446,7 +1452,15 @@ func fetchEcsClusters(ctx context.Context, meta schema.ClientMeta, parent *schem
 		if len(listClustersOutput.ClusterArns) == 0 {
 			return nil
 		}
-		describeClusterOutput, err := svc.DescribeClusters(ctx, &ecs.DescribeClustersInput{Clusters: listClustersOutput.ClusterArns}, func(o *ecs.Options) {
+		describeClusterOutput, err := svc.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: listClustersOutput.ClusterArns,
+			Include: []types.ClusterField{
+				types.ClusterFieldAttachments,
+				types.ClusterFieldConfigurations,
+				types.ClusterFieldSettings,
+				types.ClusterFieldStatistics,
+			},
+		}, func(o *ecs.Options) {
 			o.Region = region
 		})
 		if err != nil {
@@ -1473,6 +1487,18 @@ func resolveClustersSettings(ctx context.Context, meta schema.ClientMeta, resour
 	}
 	return diag.WrapError(resource.Set(c.Name, settings))
 }
+func resolveClustersSettingContainerInsights(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cluster, ok := resource.Item.(types.Cluster)
+	if !ok {
+		return diag.WrapError(fmt.Errorf("expected to have types.Cluster but got %T", resource.Item))
+	}
+	for _, s := range cluster.Settings {
+		if s.Name == types.ClusterSettingNameContainerInsights {
+			return diag.WrapError(resource.Set(c.Name, s.Value))
+		}
+	}
+	return nil
+}
 func resolveClustersStatistics(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	cluster, ok := resource.Item.(types.Cluster)
 	if !ok {
@@ -2,6 +2,7 @@ package efs
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
@@ -40,6 +41,12 @@ func EfsFilesystems() *schema.Table {
 				Type:        schema.TypeString,
 				Resolver:    ResolveEfsFilesystemBackupPolicyStatus,
 			},
+			{
+				Name:        "lifecycle_policies",
+				Description: "The lifecycle policies of the file system, null if it has no lifecycle configuration.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveEfsFilesystemLifecyclePolicies,
+			},
 			{
 				Name:        "creation_time",
 				Description: "The time that the file system was created, in seconds (since 1970-01-01T00:00:00Z). ",
@@ -148,6 +155,124 @@ func EfsFilesystems() *schema.Table {
 				Type:        schema.TypeString,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_efs_filesystem_mount_targets",
+				Description: "Provides a description of a mount target.",
+				Resolver:    fetchEfsFilesystemMountTargets,
+				Columns: []schema.Column{
+					{
+						Name:        "filesystem_cq_id",
+						Description: "Unique CloudQuery ID of aws_efs_filesystems table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "mount_target_id",
+						Description: "System-assigned mount target ID.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "subnet_id",
+						Description: "The ID of the mount target's subnet.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "vpc_id",
+						Description: "The virtual private cloud (VPC) ID that the mount target is configured in.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "ip_address",
+						Description: "Address at which the file system can be mounted by using the mount target.",
+						Type:        schema.TypeInet,
+						Resolver:    schema.IPAddressResolver("IpAddress"),
+					},
+					{
+						Name:        "availability_zone_id",
+						Description: "The unique and consistent identifier of the Availability Zone that the mount target resides in.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "availability_zone_name",
+						Description: "The name of the Availability Zone in which the mount target is located.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "network_interface_id",
+						Description: "The ID of the network interface that Amazon EFS created when it created the mount target.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "life_cycle_state",
+						Description: "Lifecycle state of the mount target.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "owner_id",
+						Description: "Amazon Web Services account ID that owns the resource.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:        "aws_efs_filesystem_access_points",
+				Description: "Provides a description of an EFS file system access point.",
+				Resolver:    fetchEfsFilesystemAccessPoints,
+				Columns: []schema.Column{
+					{
+						Name:        "filesystem_cq_id",
+						Description: "Unique CloudQuery ID of aws_efs_filesystems table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "access_point_id",
+						Description: "The ID of the access point, assigned by Amazon EFS.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "arn",
+						Description: "The unique Amazon Resource Name (ARN) associated with the access point.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("AccessPointArn"),
+					},
+					{
+						Name:        "name",
+						Description: "The name of the access point.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "posix_user",
+						Description: "The full POSIX identity, including the user ID, group ID, and secondary group IDs on the access point that is used for all file operations by NFS clients using the access point.",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveEfsFilesystemAccessPointJSONField(func(a types.AccessPointDescription) interface{} { return a.PosixUser }),
+					},
+					{
+						Name:        "root_directory",
+						Description: "The directory on the Amazon EFS file system that the access point exposes as the root directory to NFS clients using the access point.",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveEfsFilesystemAccessPointJSONField(func(a types.AccessPointDescription) interface{} { return a.RootDirectory }),
+					},
+					{
+						Name:        "life_cycle_state",
+						Description: "Identifies the lifecycle phase of the access point.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "owner_id",
+						Description: "Identified the Amazon Web Services account that owns the access point resource.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "tags",
+						Description: "The tags associated with the access point.",
+						Type:        schema.TypeJSON,
+						Resolver:    client.ResolveTags,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -192,3 +317,70 @@ func ResolveEfsFilesystemBackupPolicyStatus(ctx context.Context, meta schema.Cli
 
 	return diag.WrapError(resource.Set(c.Name, response.BackupPolicy.Status))
 }
+
+func resolveEfsFilesystemLifecyclePolicies(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(types.FileSystemDescription)
+	cl := meta.(*client.Client)
+	svc := cl.Services().EFS
+	response, err := svc.DescribeLifecycleConfiguration(ctx, &efs.DescribeLifecycleConfigurationInput{FileSystemId: p.FileSystemId})
+	if err != nil {
+		if cl.IsNotFoundError(err) {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if len(response.LifecyclePolicies) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(response.LifecyclePolicies)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+
+func fetchEfsFilesystemMountTargets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	fs := parent.Item.(types.FileSystemDescription)
+	svc := meta.(*client.Client).Services().EFS
+	config := efs.DescribeMountTargetsInput{FileSystemId: fs.FileSystemId}
+	for {
+		response, err := svc.DescribeMountTargets(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.MountTargets
+		if aws.ToString(response.NextMarker) == "" {
+			break
+		}
+		config.Marker = response.NextMarker
+	}
+	return nil
+}
+
+func fetchEfsFilesystemAccessPoints(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	fs := parent.Item.(types.FileSystemDescription)
+	svc := meta.(*client.Client).Services().EFS
+	config := efs.DescribeAccessPointsInput{FileSystemId: fs.FileSystemId}
+	for {
+		response, err := svc.DescribeAccessPoints(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.AccessPoints
+		if aws.ToString(response.NextToken) == "" {
+			break
+		}
+		config.NextToken = response.NextToken
+	}
+	return nil
+}
+
+func resolveEfsFilesystemAccessPointJSONField(getter func(a types.AccessPointDescription) interface{}) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		b, err := json.Marshal(getter(resource.Item.(types.AccessPointDescription)))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
@@ -3,6 +3,7 @@ package efs
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/efs"
 	"github.com/aws/aws-sdk-go-v2/service/efs/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -31,6 +32,35 @@ func buildEfsFilesystemsMock(t *testing.T, ctrl *gomock.Controller) client.Servi
 	m.EXPECT().DescribeBackupPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&b, nil)
 
+	lc := efs.DescribeLifecycleConfigurationOutput{}
+	err = faker.FakeData(&lc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeLifecycleConfiguration(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&lc, nil)
+
+	mt := types.MountTargetDescription{}
+	err = faker.FakeData(&mt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mt.IpAddress = aws.String("10.0.0.10")
+	m.EXPECT().DescribeMountTargets(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&efs.DescribeMountTargetsOutput{
+			MountTargets: []types.MountTargetDescription{mt},
+		}, nil)
+
+	ap := types.AccessPointDescription{}
+	err = faker.FakeData(&ap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeAccessPoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&efs.DescribeAccessPointsOutput{
+			AccessPoints: []types.AccessPointDescription{ap},
+		}, nil)
+
 	return client.Services{
 		EFS: m,
 	}
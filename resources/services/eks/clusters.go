@@ -3,6 +3,7 @@ package eks
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -194,6 +195,280 @@ func EksClusters() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:        "aws_eks_cluster_node_groups",
+				Description: "An object representing an Amazon EKS managed node group.",
+				Resolver:    fetchEksClusterNodeGroups,
+				Columns: []schema.Column{
+					{
+						Name:        "cluster_cq_id",
+						Description: "Unique CloudQuery ID of aws_eks_clusters table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "The Amazon Resource Name (ARN) associated with the managed node group.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("NodegroupArn"),
+					},
+					{
+						Name:        "name",
+						Description: "The name associated with an Amazon EKS managed node group.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("NodegroupName"),
+					},
+					{
+						Name:        "ami_type",
+						Description: "If the node group was deployed using a launch template with a custom AMI, then this is CUSTOM. For node groups that weren't deployed using a launch template, this is the AMI type that was specified in the node group configuration.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "capacity_type",
+						Description: "The capacity type of your managed node group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "created_at",
+						Description: "The Unix epoch timestamp in seconds for when the managed node group was created.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "disk_size",
+						Description: "If the node group wasn't deployed with a launch template, then this is the disk size in the node group configuration.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "health_issues",
+						Description: "Any issues that are associated with the node group.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Health.Issues"),
+					},
+					{
+						Name:        "instance_types",
+						Description: "If the node group wasn't deployed with a launch template, then this is the instance type that is associated with the node group.",
+						Type:        schema.TypeStringArray,
+					},
+					{
+						Name:        "labels",
+						Description: "The Kubernetes labels applied to the nodes in the node group.",
+						Type:        schema.TypeJSON,
+					},
+					{
+						Name:        "launch_template_id",
+						Description: "The ID of the launch template.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("LaunchTemplate.Id"),
+					},
+					{
+						Name:        "launch_template_name",
+						Description: "The name of the launch template.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("LaunchTemplate.Name"),
+					},
+					{
+						Name:        "launch_template_version",
+						Description: "The version of the launch template to use.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("LaunchTemplate.Version"),
+					},
+					{
+						Name:        "modified_at",
+						Description: "The Unix epoch timestamp in seconds for when the managed node group was last modified.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "node_role",
+						Description: "The IAM role associated with your node group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "release_version",
+						Description: "If the node group was deployed using a launch template with a custom AMI, then this is the AMI ID that was specified in the launch template. For node groups that weren't deployed using a launch template, this is the version of the Amazon EKS optimized AMI that the node group was deployed with.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "remote_access_ec2_ssh_key",
+						Description: "The Amazon EC2 SSH key that provides access for SSH communication with the nodes in the managed node group.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("RemoteAccess.Ec2SshKey"),
+					},
+					{
+						Name:        "remote_access_source_security_groups",
+						Description: "The security groups that are allowed SSH access (port 22) to the nodes.",
+						Type:        schema.TypeStringArray,
+						Resolver:    schema.PathResolver("RemoteAccess.SourceSecurityGroups"),
+					},
+					{
+						Name:        "resources",
+						Description: "The resources associated with the node group, such as Auto Scaling groups and security groups for remote access.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Resources"),
+					},
+					{
+						Name:        "scaling_config_desired_size",
+						Description: "The current number of nodes that the managed node group should maintain.",
+						Type:        schema.TypeInt,
+						Resolver:    schema.PathResolver("ScalingConfig.DesiredSize"),
+					},
+					{
+						Name:        "scaling_config_max_size",
+						Description: "The maximum number of nodes that the managed node group can scale out to.",
+						Type:        schema.TypeInt,
+						Resolver:    schema.PathResolver("ScalingConfig.MaxSize"),
+					},
+					{
+						Name:        "scaling_config_min_size",
+						Description: "The minimum number of nodes that the managed node group can scale in to.",
+						Type:        schema.TypeInt,
+						Resolver:    schema.PathResolver("ScalingConfig.MinSize"),
+					},
+					{
+						Name:        "status",
+						Description: "The current status of the managed node group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "subnets",
+						Description: "The subnets that were specified for the Auto Scaling group that is associated with your node group.",
+						Type:        schema.TypeStringArray,
+					},
+					{
+						Name:        "tags",
+						Description: "The metadata applied to the node group to assist with categorization and organization.",
+						Type:        schema.TypeJSON,
+					},
+					{
+						Name:        "taints",
+						Description: "The Kubernetes taints to be applied to the nodes in the node group when they are created.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Taints"),
+					},
+					{
+						Name:        "version",
+						Description: "The Kubernetes version of the managed node group.",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+			{
+				Name:        "aws_eks_cluster_fargate_profiles",
+				Description: "An object representing an Fargate profile.",
+				Resolver:    fetchEksClusterFargateProfiles,
+				Columns: []schema.Column{
+					{
+						Name:        "cluster_cq_id",
+						Description: "Unique CloudQuery ID of aws_eks_clusters table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "The full Amazon Resource Name (ARN) of the Fargate profile.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("FargateProfileArn"),
+					},
+					{
+						Name:        "name",
+						Description: "The name of the Fargate profile.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("FargateProfileName"),
+					},
+					{
+						Name:        "created_at",
+						Description: "The Unix epoch timestamp in seconds for when the Fargate profile was created.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "pod_execution_role_arn",
+						Description: "The Amazon Resource Name (ARN) of the pod execution role to use for pods that match the selectors in the Fargate profile.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "selectors",
+						Description: "The selectors to match for pods to use this Fargate profile.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Selectors"),
+					},
+					{
+						Name:        "status",
+						Description: "The current status of the Fargate profile.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "subnets",
+						Description: "The IDs of subnets to launch pods into.",
+						Type:        schema.TypeStringArray,
+					},
+					{
+						Name:        "tags",
+						Description: "The metadata applied to the Fargate profile to assist with categorization and organization.",
+						Type:        schema.TypeJSON,
+					},
+				},
+			},
+			{
+				Name:        "aws_eks_cluster_addons",
+				Description: "An Amazon EKS add-on.",
+				Resolver:    fetchEksClusterAddons,
+				Columns: []schema.Column{
+					{
+						Name:        "cluster_cq_id",
+						Description: "Unique CloudQuery ID of aws_eks_clusters table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "The Amazon Resource Name (ARN) of the add-on.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("AddonArn"),
+					},
+					{
+						Name:        "name",
+						Description: "The name of the add-on.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("AddonName"),
+					},
+					{
+						Name:        "version",
+						Description: "The version of the add-on.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("AddonVersion"),
+					},
+					{
+						Name:        "created_at",
+						Description: "The date and time that the add-on was created.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "health_issues",
+						Description: "An object that represents the health issues of the add-on.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Health.Issues"),
+					},
+					{
+						Name:        "modified_at",
+						Description: "The date and time that the add-on was last modified.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "service_account_role_arn",
+						Description: "The Amazon Resource Name (ARN) of the IAM role that is bound to the Kubernetes service account used by the add-on.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "status",
+						Description: "The status of the add-on.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "tags",
+						Description: "The metadata that you apply to the add-on to assist with categorization and organization.",
+						Type:        schema.TypeJSON,
+					},
+				},
+			},
 		},
 	}
 }
@@ -238,3 +513,105 @@ func resolveEksClusterLoggingTypes(ctx context.Context, meta schema.ClientMeta,
 	}
 	return diag.WrapError(resource.Set("types", logTypes))
 }
+func fetchEksClusterNodeGroups(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cluster := parent.Item.(*types.Cluster)
+	c := meta.(*client.Client)
+	svc := c.Services().Eks
+	config := eks.ListNodegroupsInput{ClusterName: cluster.Name}
+	for {
+		output, err := svc.ListNodegroups(ctx, &config, func(options *eks.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for _, name := range output.Nodegroups {
+			describeOutput, err := svc.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
+				ClusterName:   cluster.Name,
+				NodegroupName: aws.String(name),
+			}, func(options *eks.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				if c.IsNotFoundError(err) {
+					continue
+				}
+				return diag.WrapError(err)
+			}
+			res <- describeOutput.Nodegroup
+		}
+		if output.NextToken == nil {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func fetchEksClusterFargateProfiles(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cluster := parent.Item.(*types.Cluster)
+	c := meta.(*client.Client)
+	svc := c.Services().Eks
+	config := eks.ListFargateProfilesInput{ClusterName: cluster.Name}
+	for {
+		output, err := svc.ListFargateProfiles(ctx, &config, func(options *eks.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for _, name := range output.FargateProfileNames {
+			describeOutput, err := svc.DescribeFargateProfile(ctx, &eks.DescribeFargateProfileInput{
+				ClusterName:        cluster.Name,
+				FargateProfileName: aws.String(name),
+			}, func(options *eks.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				if c.IsNotFoundError(err) {
+					continue
+				}
+				return diag.WrapError(err)
+			}
+			res <- describeOutput.FargateProfile
+		}
+		if output.NextToken == nil {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+func fetchEksClusterAddons(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cluster := parent.Item.(*types.Cluster)
+	c := meta.(*client.Client)
+	svc := c.Services().Eks
+	config := eks.ListAddonsInput{ClusterName: cluster.Name}
+	for {
+		output, err := svc.ListAddons(ctx, &config, func(options *eks.Options) {
+			options.Region = c.Region
+		})
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for _, name := range output.Addons {
+			describeOutput, err := svc.DescribeAddon(ctx, &eks.DescribeAddonInput{
+				ClusterName: cluster.Name,
+				AddonName:   aws.String(name),
+			}, func(options *eks.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				if c.IsNotFoundError(err) {
+					continue
+				}
+				return diag.WrapError(err)
+			}
+			res <- describeOutput.Addon
+		}
+		if output.NextToken == nil {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -24,6 +24,39 @@ func buildEksClusters(t *testing.T, ctrl *gomock.Controller) client.Services {
 		}, nil)
 	m.EXPECT().DescribeCluster(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&l, nil)
+
+	ng := eks.DescribeNodegroupOutput{}
+	if err := faker.FakeData(&ng); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListNodegroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&eks.ListNodegroupsOutput{
+			Nodegroups: []string{"test-node-group"},
+		}, nil)
+	m.EXPECT().DescribeNodegroup(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&ng, nil)
+
+	fp := eks.DescribeFargateProfileOutput{}
+	if err := faker.FakeData(&fp); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListFargateProfiles(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&eks.ListFargateProfilesOutput{
+			FargateProfileNames: []string{"test-fargate-profile"},
+		}, nil)
+	m.EXPECT().DescribeFargateProfile(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&fp, nil)
+
+	addon := eks.DescribeAddonOutput{}
+	if err := faker.FakeData(&addon); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListAddons(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&eks.ListAddonsOutput{
+			Addons: []string{"test-addon"},
+		}, nil)
+	m.EXPECT().DescribeAddon(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&addon, nil)
 	return client.Services{
 		Eks: m,
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -219,6 +220,12 @@ func Clusters() *schema.Table {
 				Description: "A flag that enables in-transit encryption when set to true",
 				Type:        schema.TypeBool,
 			},
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the cluster.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveElasticacheClusterTags,
+			},
 		},
 		Relations: []*schema.Table{
 			{
@@ -413,3 +420,12 @@ func fetchElasticacheClusters(ctx context.Context, meta schema.ClientMeta, paren
 		describeCacheClustersInput.Marker = describeCacheClustersOutput.Marker
 	}
 }
+func resolveElasticacheClusterTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cluster := resource.Item.(types.CacheCluster)
+	svc := meta.(*client.Client).Services().ElastiCache
+	output, err := svc.ListTagsForResource(ctx, &elasticache.ListTagsForResourceInput{ResourceName: cluster.ARN})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, client.TagsToMap(output.TagList)))
+}
@@ -21,6 +21,12 @@ func buildElasticacheClusters(t *testing.T, ctrl *gomock.Controller) client.Serv
 
 	mockElasticache.EXPECT().DescribeCacheClusters(gomock.Any(), gomock.Any(), gomock.Any()).Return(&output, nil)
 
+	tags := elasticache.ListTagsForResourceOutput{}
+	if err := faker.FakeData(&tags); err != nil {
+		t.Fatal(err)
+	}
+	mockElasticache.EXPECT().ListTagsForResource(gomock.Any(), gomock.Any(), gomock.Any()).Times(len(output.CacheClusters)).Return(&tags, nil)
+
 	return client.Services{
 		ElastiCache: mockElasticache,
 	}
@@ -118,9 +118,147 @@ resource "aws" "elasticache" "clusters" {
     }
   }
 
+  userDefinedColumn "tags" {
+    type              = "json"
+    description       = "The tags assigned to the cluster."
+    generate_resolver = true
+  }
+
   # Actually skipping a relation.
   # Skipping because having subrelation is a bit much...
   column "pending_modified_values_log_delivery_configurations" {
     skip = true
   }
-}
\ No newline at end of file
+}
+
+resource "aws" "elasticache" "parameter_groups" {
+  path = "github.com/aws/aws-sdk-go-v2/service/elasticache/types.CacheParameterGroup"
+
+  ignoreError "IgnoreCommonErrors" {
+    path = "github.com/cloudquery/cq-provider-aws/client.IgnoreCommonErrors"
+  }
+
+  deleteFilter "AccountRegionFilter" {
+    path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountRegionFilter"
+  }
+
+  multiplex "AwsAccountRegion" {
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountRegionMultiplexer"
+    params = ["elasticache"]
+  }
+
+  options {
+    primary_keys = ["arn"]
+  }
+
+  userDefinedColumn "account_id" {
+    description = "The AWS Account ID of the resource."
+    type        = "string"
+    resolver "resolveAWSAccount" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSAccount"
+    }
+  }
+
+  userDefinedColumn "region" {
+    type        = "string"
+    description = "The AWS Region of the resource."
+    resolver "resolveAWSRegion" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSRegion"
+    }
+  }
+
+  column "cache_parameter_group_family" {
+    rename = "family"
+  }
+
+  column "cache_parameter_group_name" {
+    rename = "name"
+  }
+
+  user_relation "aws" "elasticache" "parameters" {
+    path = "github.com/aws/aws-sdk-go-v2/service/elasticache/types.Parameter"
+  }
+}
+
+resource "aws" "elasticache" "replication_groups" {
+  path = "github.com/aws/aws-sdk-go-v2/service/elasticache/types.ReplicationGroup"
+
+  ignoreError "IgnoreCommonErrors" {
+    path = "github.com/cloudquery/cq-provider-aws/client.IgnoreCommonErrors"
+  }
+
+  deleteFilter "AccountRegionFilter" {
+    path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountRegionFilter"
+  }
+
+  multiplex "AwsAccountRegion" {
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountRegionMultiplexer"
+    params = ["elasticache"]
+  }
+
+  options {
+    primary_keys = ["arn"]
+  }
+
+  userDefinedColumn "account_id" {
+    description = "The AWS Account ID of the resource."
+    type        = "string"
+    resolver "resolveAWSAccount" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSAccount"
+    }
+  }
+
+  userDefinedColumn "region" {
+    type        = "string"
+    description = "The AWS Region of the resource."
+    resolver "resolveAWSRegion" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSRegion"
+    }
+  }
+
+  column "replication_group_id" {
+    rename = "id"
+  }
+
+  column "replication_group_create_time" {
+    rename = "create_time"
+  }
+
+  userDefinedColumn "node_group_count" {
+    type              = "int"
+    description       = "The number of node groups (shards) in the replication group."
+    generate_resolver = true
+  }
+
+  column "node_groups" {
+    skip = true
+  }
+
+  column "global_replication_group_info" {
+    skip = true
+  }
+
+  column "log_delivery_configurations" {
+    skip = true
+  }
+
+  column "pending_modified_values" {
+    skip = true
+  }
+
+  column "auto_minor_version_upgrade" {
+    skip = true
+  }
+
+  column "data_tiering" {
+    skip = true
+  }
+
+  column "member_clusters_outpost_arns" {
+    skip = true
+  }
+
+  column "snapshotting_cluster_id" {
+    skip = true
+  }
+}
@@ -0,0 +1,36 @@
+package elasticache
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildElasticacheParameterGroups(t *testing.T, ctrl *gomock.Controller) client.Services {
+	mockElasticache := mocks.NewMockElastiCache(ctrl)
+	groups := elasticache.DescribeCacheParameterGroupsOutput{}
+	if err := faker.FakeData(&groups); err != nil {
+		t.Fatal(err)
+	}
+	groups.Marker = nil
+	mockElasticache.EXPECT().DescribeCacheParameterGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(&groups, nil)
+
+	parameters := elasticache.DescribeCacheParametersOutput{}
+	if err := faker.FakeData(&parameters); err != nil {
+		t.Fatal(err)
+	}
+	parameters.Marker = nil
+	mockElasticache.EXPECT().DescribeCacheParameters(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&parameters, nil)
+
+	return client.Services{
+		ElastiCache: mockElasticache,
+	}
+}
+
+func TestElasticacheParameterGroups(t *testing.T) {
+	client.AwsMockTestHelper(t, ParameterGroups(), buildElasticacheParameterGroups, client.TestOptions{})
+}
@@ -0,0 +1,175 @@
+package elasticache
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+//go:generate cq-gen --resource replication_groups --config ./gen.hcl --output .
+func ReplicationGroups() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_elasticache_replication_groups",
+		Description:  "Contains all of the attributes of a specific Redis replication group.",
+		Resolver:     fetchElasticacheReplicationGroups,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("elasticache"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The ARN (Amazon Resource Name) of the replication group.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ARN"),
+			},
+			{
+				Name:        "id",
+				Description: "The identifier for the replication group.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ReplicationGroupId"),
+			},
+			{
+				Name:        "status",
+				Description: "The current state of this replication group - creating, available, modifying, deleting, create-failed, snapshotting.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "description",
+				Description: "The user supplied description of the replication group.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "node_group_count",
+				Description: "The number of node groups (shards) in the replication group.",
+				Type:        schema.TypeInt,
+				Resolver:    resolveElasticacheReplicationGroupNodeGroupCount,
+			},
+			{
+				Name:        "automatic_failover",
+				Description: "Indicates the status of automatic failover for this Redis replication group.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "multi_az",
+				Description: "A flag indicating if you have Multi-AZ enabled to enhance fault tolerance.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("MultiAZ"),
+			},
+			{
+				Name:        "at_rest_encryption_enabled",
+				Description: "A flag that enables encryption at-rest when set to true.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "transit_encryption_enabled",
+				Description: "A flag that enables in-transit encryption when set to true.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "auth_token_enabled",
+				Description: "A flag that enables using an AuthToken (password) when issuing Redis commands.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "auth_token_last_modified_date",
+				Description: "The date the auth token was last modified",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "kms_key_id",
+				Description: "The ID of the KMS key used to encrypt the disk in the cluster.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "snapshot_retention_limit",
+				Description: "The number of days for which ElastiCache retains automatic cluster snapshots before deleting them.",
+				Type:        schema.TypeInt,
+			},
+			{
+				Name:        "snapshot_window",
+				Description: "The daily time range (in UTC) during which ElastiCache begins taking a daily snapshot of your node group (shard).",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "cache_node_type",
+				Description: "The name of the compute and memory capacity node type for each node in the replication group.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "cluster_enabled",
+				Description: "A flag indicating whether or not this replication group is cluster enabled; i.e., whether its data can be partitioned across multiple shards (API/CLI: node groups)",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "configuration_endpoint_address",
+				Description: "The DNS hostname of the cache node.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ConfigurationEndpoint.Address"),
+			},
+			{
+				Name:        "configuration_endpoint_port",
+				Description: "The port number that the cache engine is listening on.",
+				Type:        schema.TypeInt,
+				Resolver:    schema.PathResolver("ConfigurationEndpoint.Port"),
+			},
+			{
+				Name:        "member_clusters",
+				Description: "The names of all the cache clusters that are part of this replication group.",
+				Type:        schema.TypeStringArray,
+			},
+			{
+				Name:        "user_group_ids",
+				Description: "The ID of the user group associated to the replication group.",
+				Type:        schema.TypeStringArray,
+			},
+			{
+				Name:        "create_time",
+				Description: "The date and time when the cluster was created.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("ReplicationGroupCreateTime"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchElasticacheReplicationGroups(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().ElastiCache
+	var input elasticache.DescribeReplicationGroupsInput
+	for {
+		output, err := svc.DescribeReplicationGroups(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.ReplicationGroups
+		if aws.ToString(output.Marker) == "" {
+			return nil
+		}
+		input.Marker = output.Marker
+	}
+}
+func resolveElasticacheReplicationGroupNodeGroupCount(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	g := resource.Item.(types.ReplicationGroup)
+	return diag.WrapError(resource.Set(c.Name, len(g.NodeGroups)))
+}
@@ -0,0 +1,29 @@
+package elasticache
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildElasticacheReplicationGroups(t *testing.T, ctrl *gomock.Controller) client.Services {
+	mockElasticache := mocks.NewMockElastiCache(ctrl)
+	output := elasticache.DescribeReplicationGroupsOutput{}
+	if err := faker.FakeData(&output); err != nil {
+		t.Fatal(err)
+	}
+	output.Marker = nil
+	mockElasticache.EXPECT().DescribeReplicationGroups(gomock.Any(), gomock.Any(), gomock.Any()).Return(&output, nil)
+
+	return client.Services{
+		ElastiCache: mockElasticache,
+	}
+}
+
+func TestElasticacheReplicationGroups(t *testing.T) {
+	client.AwsMockTestHelper(t, ReplicationGroups(), buildElasticacheReplicationGroups, client.TestOptions{})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
@@ -317,6 +318,119 @@ func EmrClusters() *schema.Table {
 				Type:        schema.TypeBool,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_emr_cluster_instance_groups",
+				Description: "This entity represents an instance group, which is a group of instances that have common purpose.",
+				Resolver:    fetchEmrClusterInstanceGroups,
+				Columns: []schema.Column{
+					{
+						Name:        "cluster_cq_id",
+						Description: "Unique CloudQuery ID of aws_emr_clusters table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "id",
+						Description: "The identifier of the instance group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "name",
+						Description: "The name of the instance group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "instance_group_type",
+						Description: "The type of the instance group. Valid values are MASTER, CORE or TASK.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "instance_type",
+						Description: "The EC2 instance type for all instances in the instance group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "market",
+						Description: "The marketplace to provision instances for this group. Valid values are ON_DEMAND or SPOT.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "bid_price",
+						Description: "The bid price for each EC2 Spot Instance type as defined by InstanceType.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "requested_instance_count",
+						Description: "The target number of instances for the instance group.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "running_instance_count",
+						Description: "The number of instances currently running in this instance group.",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "ebs_optimized",
+						Description: "If the instance group is EBS-optimized.",
+						Type:        schema.TypeBool,
+					},
+					{
+						Name:        "ebs_block_devices",
+						Description: "The EBS block devices that are mapped to this instance group.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("EbsBlockDevices"),
+					},
+					{
+						Name:        "configurations",
+						Description: "The list of configurations supplied for an Amazon EMR cluster instance group.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Configurations"),
+					},
+					{
+						Name:        "custom_ami_id",
+						Description: "The custom AMI ID to use for the provisioned instance group.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "state",
+						Description: "The current state of the instance group.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("Status.State"),
+					},
+					{
+						Name:        "state_change_reason_code",
+						Description: "The programmatic code for the state change reason.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("Status.StateChangeReason.Code"),
+					},
+					{
+						Name:        "state_change_reason_message",
+						Description: "The status change reason description.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("Status.StateChangeReason.Message"),
+					},
+					{
+						Name:        "creation_date_time",
+						Description: "The creation date and time of the instance group.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.PathResolver("Status.Timeline.CreationDateTime"),
+					},
+					{
+						Name:        "end_date_time",
+						Description: "The date and time when the instance group terminated.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.PathResolver("Status.Timeline.EndDateTime"),
+					},
+					{
+						Name:        "ready_date_time",
+						Description: "The date and time when the instance group became ready to perform tasks.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.PathResolver("Status.Timeline.ReadyDateTime"),
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -346,3 +460,21 @@ func fetchEmrClusters(ctx context.Context, meta schema.ClientMeta, parent *schem
 	}
 	return nil
 }
+
+func fetchEmrClusterInstanceGroups(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cluster := parent.Item.(*types.Cluster)
+	config := emr.ListInstanceGroupsInput{ClusterId: cluster.Id}
+	svc := meta.(*client.Client).Services().EMR
+	for {
+		response, err := svc.ListInstanceGroups(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.InstanceGroups
+		if aws.ToString(response.Marker) == "" {
+			break
+		}
+		config.Marker = response.Marker
+	}
+	return nil
+}
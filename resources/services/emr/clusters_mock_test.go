@@ -3,6 +3,7 @@ package emr
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/emr"
 	"github.com/aws/aws-sdk-go-v2/service/emr/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -40,6 +41,31 @@ func buildEMRClusters(t *testing.T, ctrl *gomock.Controller) client.Services {
 		&emr.DescribeClusterOutput{Cluster: &cluster},
 		nil,
 	)
+
+	var group types.InstanceGroup
+	groupSkipFields := []string{"AutoScalingPolicy", "Configurations", "InstanceGroupType", "LastSuccessfullyAppliedConfigurations", "Market", "Status"}
+	if err := faker.FakeDataSkipFields(&group, groupSkipFields); err != nil {
+		t.Fatal(err)
+	}
+	group.Configurations = []types.Configuration{config}
+	group.InstanceGroupType = types.InstanceGroupTypeMaster
+	group.Market = types.MarketTypeOnDemand
+	var timeline types.InstanceGroupTimeline
+	if err := faker.FakeData(&timeline); err != nil {
+		t.Fatal(err)
+	}
+	group.Status = &types.InstanceGroupStatus{
+		State: types.InstanceGroupStateRunning,
+		StateChangeReason: &types.InstanceGroupStateChangeReason{
+			Code:    types.InstanceGroupStateChangeReasonCodeClusterTerminated,
+			Message: aws.String("cluster terminated"),
+		},
+		Timeline: &timeline,
+	}
+	mock.EXPECT().ListInstanceGroups(gomock.Any(), &emr.ListInstanceGroupsInput{ClusterId: cluster.Id}).Return(
+		&emr.ListInstanceGroupsOutput{InstanceGroups: []types.InstanceGroup{group}},
+		nil,
+	)
 	return client.Services{EMR: mock}
 }
 
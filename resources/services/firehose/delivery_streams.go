@@ -2,13 +2,17 @@ package firehose
 
 import (
 	"context"
+	"errors"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/firehose"
 	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/aws/smithy-go"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
 )
 
 //go:generate cq-gen --resource delivery_streams --config gen.hcl --output .
@@ -45,6 +49,36 @@ func DeliveryStreams() *schema.Table {
 				Type:        schema.TypeString,
 				Resolver:    schema.PathResolver("DeliveryStreamARN"),
 			},
+			{
+				Name:        "is_untagged",
+				Description: "True when the delivery stream has no tags",
+				Type:        schema.TypeBool,
+				Resolver:    client.ResolveUntagged,
+			},
+			{
+				Name:        "max_buffering_interval_seconds",
+				Description: "The largest buffering interval, in seconds, across all destinations of the delivery stream. Destinations without buffering hints count as the default of 300 seconds",
+				Type:        schema.TypeBigInt,
+				Resolver:    resolveFirehoseDeliveryStreamMaxBufferingIntervalSeconds,
+			},
+			{
+				Name:        "source_cross_account",
+				Description: "True when the source Kinesis data stream belongs to a different account than the delivery stream",
+				Type:        schema.TypeBool,
+				Resolver:    resolveFirehoseDeliveryStreamSourceCrossAccount,
+			},
+			{
+				Name:        "encryption_customer_managed",
+				Description: "True when server-side encryption is enabled with a customer managed CMK",
+				Type:        schema.TypeBool,
+				Resolver:    resolveFirehoseDeliveryStreamEncryptionCustomerManaged,
+			},
+			{
+				Name:        "destination_count",
+				Description: "The number of destinations of the delivery stream",
+				Type:        schema.TypeBigInt,
+				Resolver:    resolveFirehoseDeliveryStreamDestinationCount,
+			},
 			{
 				Name:        "delivery_stream_arn",
 				Description: "The Amazon Resource Name (ARN) of the delivery stream",
@@ -159,6 +193,19 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
+					{
+						Name:        "opensearch_public_delivery",
+						Description: "True when the destination has no VPC configuration and data is delivered over the public internet",
+						Type:        schema.TypeBool,
+						Resolver:    resolveFirehoseDeliveryStreamOpenSearchDestinationOpensearchPublicDelivery,
+					},
+					{
+						Name:        "index_rotation_hours",
+						Description: "The index rotation period in hours (0 for NoRotation)",
+						Type:        schema.TypeBigInt,
+						Resolver:    resolveFirehoseDeliveryStreamOpenSearchDestinationIndexRotationHours,
+					},
 					{
 						Name:     "buffering_hints_interval_in_seconds",
 						Type:     schema.TypeBigInt,
@@ -343,6 +390,7 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
 					{
 						Name:        "buffering_hints_interval_in_seconds",
 						Description: "Buffer incoming data for the specified period of time, in seconds, before delivering it to the destination",
@@ -537,6 +585,7 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
 					{
 						Name:        "bucket_arn",
 						Description: "The ARN of the S3 bucket",
@@ -882,6 +931,7 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
 					{
 						Name:        "buffering_hints_interval_in_seconds",
 						Description: "Buffer incoming data for the specified period of time, in seconds, before delivering it to the destination",
@@ -1050,6 +1100,7 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
 					{
 						Name:        "cluster_j_db_c_url",
 						Description: "The database connection string",
@@ -1283,6 +1334,7 @@ func DeliveryStreams() *schema.Table {
 						Type:        schema.TypeJSON,
 						Resolver:    schema.PathResolver("ProcessingConfiguration.Processors"),
 					},
+					hasUnknownProcessorTypeColumn,
 					{
 						Name:        "cloud_watch_logging_options_enabled",
 						Description: "Enables or disables CloudWatch logging",
@@ -1413,6 +1465,45 @@ func DeliveryStreams() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:        "aws_firehose_delivery_stream_processors",
+				Description: "A data processor of a delivery stream destination",
+				Resolver:    fetchFirehoseDeliveryStreamProcessors,
+				Columns: []schema.Column{
+					{
+						Name:        "delivery_stream_cq_id",
+						Description: "Unique CloudQuery ID of aws_firehose_delivery_streams table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "destination_id",
+						Description: "The ID of the destination the processor belongs to",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "destination_type",
+						Description: "The type of the destination the processor belongs to, one of open_search, elasticsearch, extended_s3, http, redshift or splunk",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "position",
+						Description: "The position of the processor in the processing configuration of the destination",
+						Type:        schema.TypeInt,
+					},
+					{
+						Name:        "type",
+						Description: "The type of processor",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "parameters",
+						Description: "The processor parameters, keyed by parameter name",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveFirehoseDeliveryStreamProcessorParameters,
+					},
+				},
+			},
 		},
 	}
 }
@@ -1445,11 +1536,222 @@ func resolveFirehoseDeliveryStreamTags(ctx context.Context, meta schema.ClientMe
 	}
 	return diag.WrapError(resource.Set(c.Name, client.TagsToMap(tags)))
 }
+func resolveFirehoseDeliveryStreamMaxBufferingIntervalSeconds(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	stream := resource.Item.(*types.DeliveryStreamDescription)
+	if len(stream.Destinations) == 0 {
+		return nil
+	}
+	var maxInterval *int32
+	for _, d := range stream.Destinations {
+		for _, interval := range destinationBufferingIntervals(d) {
+			if maxInterval == nil || interval > *maxInterval {
+				maxInterval = aws.Int32(interval)
+			}
+		}
+	}
+	return diag.WrapError(resource.Set(c.Name, maxInterval))
+}
+func resolveFirehoseDeliveryStreamSourceCrossAccount(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	stream := resource.Item.(*types.DeliveryStreamDescription)
+	if stream.Source == nil || stream.Source.KinesisStreamSourceDescription == nil || stream.Source.KinesisStreamSourceDescription.KinesisStreamARN == nil {
+		return nil
+	}
+	sourceArn, err := arn.Parse(*stream.Source.KinesisStreamSourceDescription.KinesisStreamARN)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, sourceArn.AccountID != meta.(*client.Client).AccountID))
+}
+func resolveFirehoseDeliveryStreamEncryptionCustomerManaged(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	config := resource.Item.(*types.DeliveryStreamDescription).DeliveryStreamEncryptionConfiguration
+	customerManaged := config != nil && config.Status == types.DeliveryStreamEncryptionStatusEnabled && config.KeyType == types.KeyTypeCustomerManagedCmk
+	return diag.WrapError(resource.Set(c.Name, customerManaged))
+}
+func resolveFirehoseDeliveryStreamDestinationCount(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	stream := resource.Item.(*types.DeliveryStreamDescription)
+	return diag.WrapError(resource.Set(c.Name, len(stream.Destinations)))
+}
+func resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	config, ok := funk.Get(resource.Item, "ProcessingConfiguration").(*types.ProcessingConfiguration)
+	if !ok || config == nil {
+		return nil
+	}
+	for _, p := range config.Processors {
+		if !knownProcessorTypes[p.Type] {
+			return diag.WrapError(resource.Set(c.Name, true))
+		}
+	}
+	return diag.WrapError(resource.Set(c.Name, false))
+}
+func fetchFirehoseDeliveryStreamProcessors(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	stream := parent.Item.(*types.DeliveryStreamDescription)
+	for _, d := range stream.Destinations {
+		for destinationType, config := range destinationProcessingConfigurations(d) {
+			if config == nil {
+				continue
+			}
+			for i, p := range config.Processors {
+				res <- DeliveryStreamProcessor{
+					Processor:       p,
+					DestinationId:   d.DestinationId,
+					DestinationType: destinationType,
+					Position:        i,
+				}
+			}
+		}
+	}
+	return nil
+}
+func resolveFirehoseDeliveryStreamProcessorParameters(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(DeliveryStreamProcessor)
+	parameters := make(map[string]interface{}, len(p.Parameters))
+	for _, param := range p.Parameters {
+		parameters[string(param.ParameterName)] = aws.ToString(param.ParameterValue)
+	}
+	return diag.WrapError(resource.Set(c.Name, parameters))
+}
+func resolveFirehoseDeliveryStreamOpenSearchDestinationOpensearchPublicDelivery(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	destination := resource.Item.(*types.AmazonopensearchserviceDestinationDescription)
+	return diag.WrapError(resource.Set(c.Name, destination.VpcConfigurationDescription == nil))
+}
+func resolveFirehoseDeliveryStreamOpenSearchDestinationIndexRotationHours(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	destination := resource.Item.(*types.AmazonopensearchserviceDestinationDescription)
+	return diag.WrapError(resource.Set(c.Name, indexRotationHours(destination.IndexRotationPeriod)))
+}
 
 // ====================================================================================================================
 //                                                  User Defined Helpers
 // ====================================================================================================================
 
+// DeliveryStreamProcessor is a processor of a delivery stream destination, with the destination it belongs to
+type DeliveryStreamProcessor struct {
+	types.Processor
+	DestinationId   *string
+	DestinationType string
+	Position        int
+}
+
+// destinationProcessingConfigurations returns the processing configuration of every destination type present in d,
+// keyed by the destination type used in the relation table names
+func destinationProcessingConfigurations(d types.DestinationDescription) map[string]*types.ProcessingConfiguration {
+	configs := make(map[string]*types.ProcessingConfiguration)
+	if o := d.AmazonopensearchserviceDestinationDescription; o != nil {
+		configs["open_search"] = o.ProcessingConfiguration
+	}
+	if e := d.ElasticsearchDestinationDescription; e != nil {
+		configs["elasticsearch"] = e.ProcessingConfiguration
+	}
+	if e := d.ExtendedS3DestinationDescription; e != nil {
+		configs["extended_s3"] = e.ProcessingConfiguration
+	}
+	if h := d.HttpEndpointDestinationDescription; h != nil {
+		configs["http"] = h.ProcessingConfiguration
+	}
+	if r := d.RedshiftDestinationDescription; r != nil {
+		configs["redshift"] = r.ProcessingConfiguration
+	}
+	if s := d.SplunkDestinationDescription; s != nil {
+		configs["splunk"] = s.ProcessingConfiguration
+	}
+	return configs
+}
+
+// knownProcessorTypes are the processor types modeled by the provider
+var knownProcessorTypes = map[types.ProcessorType]bool{
+	types.ProcessorTypeLambda:                  true,
+	types.ProcessorTypeMetadataExtraction:      true,
+	types.ProcessorTypeRecordDeAggregation:     true,
+	types.ProcessorTypeAppendDelimiterToRecord: true,
+}
+
+// hasUnknownProcessorTypeColumn is shared by the destination relations that have a processing configuration
+var hasUnknownProcessorTypeColumn = schema.Column{
+	Name:        "has_unknown_processor_type",
+	Description: "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord",
+	Type:        schema.TypeBool,
+	Resolver:    resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType,
+}
+
+// defaultBufferingIntervalSeconds is the interval Firehose applies when a destination has no buffering hints
+const defaultBufferingIntervalSeconds int32 = 300
+
+// destinationBufferingIntervals returns the buffering interval of every destination type present in d
+func destinationBufferingIntervals(d types.DestinationDescription) []int32 {
+	var intervals []int32
+	add := func(interval *int32) {
+		if interval == nil {
+			interval = aws.Int32(defaultBufferingIntervalSeconds)
+		}
+		intervals = append(intervals, *interval)
+	}
+	if o := d.AmazonopensearchserviceDestinationDescription; o != nil {
+		if o.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(o.BufferingHints.IntervalInSeconds)
+		}
+	}
+	if e := d.ElasticsearchDestinationDescription; e != nil {
+		if e.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(e.BufferingHints.IntervalInSeconds)
+		}
+	}
+	if e := d.ExtendedS3DestinationDescription; e != nil {
+		if e.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(e.BufferingHints.IntervalInSeconds)
+		}
+	}
+	if h := d.HttpEndpointDestinationDescription; h != nil {
+		if h.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(h.BufferingHints.IntervalInSeconds)
+		}
+	}
+	// Redshift delivery is staged through the intermediate S3 bucket, so its hints apply
+	if r := d.RedshiftDestinationDescription; r != nil {
+		if r.S3DestinationDescription == nil || r.S3DestinationDescription.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(r.S3DestinationDescription.BufferingHints.IntervalInSeconds)
+		}
+	}
+	if s := d.S3DestinationDescription; s != nil {
+		if s.BufferingHints == nil {
+			add(nil)
+		} else {
+			add(s.BufferingHints.IntervalInSeconds)
+		}
+	}
+	// Splunk doesn't expose buffering hints
+	if d.SplunkDestinationDescription != nil {
+		add(nil)
+	}
+	return intervals
+}
+
+// indexRotationHours maps an index rotation period to hours, returning nil for absent or unknown periods
+func indexRotationHours(period types.AmazonopensearchserviceIndexRotationPeriod) *int {
+	switch period {
+	case types.AmazonopensearchserviceIndexRotationPeriodNoRotation:
+		return aws.Int(0)
+	case types.AmazonopensearchserviceIndexRotationPeriodOneHour:
+		return aws.Int(1)
+	case types.AmazonopensearchserviceIndexRotationPeriodOneDay:
+		return aws.Int(24)
+	case types.AmazonopensearchserviceIndexRotationPeriodOneWeek:
+		return aws.Int(168)
+	case types.AmazonopensearchserviceIndexRotationPeriodOneMonth:
+		return aws.Int(720)
+	default:
+		return nil
+	}
+}
+
 func listDeliveryStreams(ctx context.Context, meta schema.ClientMeta, detailChan chan<- interface{}) error {
 	c := meta.(*client.Client)
 	svc := c.Services().Firehose
@@ -1462,7 +1764,8 @@ func listDeliveryStreams(ctx context.Context, meta schema.ClientMeta, detailChan
 		for _, item := range response.DeliveryStreamNames {
 			detailChan <- item
 		}
-		if !aws.ToBool(response.HasMoreDeliveryStreams) {
+		// a truncated page can come back empty, there is no name to continue from so stop here
+		if !aws.ToBool(response.HasMoreDeliveryStreams) || len(response.DeliveryStreamNames) == 0 {
 			break
 		}
 		input.ExclusiveStartDeliveryStreamName = aws.String(response.DeliveryStreamNames[len(response.DeliveryStreamNames)-1])
@@ -1473,8 +1776,13 @@ func deliveryStreamDetail(ctx context.Context, meta schema.ClientMeta, resultsCh
 	c := meta.(*client.Client)
 	streamName := listInfo.(string)
 	svc := c.Services().Firehose
-	streamSummary, err := svc.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
-		DeliveryStreamName: aws.String(streamName),
+	var streamSummary *firehose.DescribeDeliveryStreamOutput
+	err := c.RetryOnError(ctx, isDescribeDeliveryStreamThrottled, func() error {
+		var err error
+		streamSummary, err = svc.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
+			DeliveryStreamName: aws.String(streamName),
+		})
+		return err
 	})
 	if err != nil {
 		if c.IsNotFoundError(err) {
@@ -1485,3 +1793,10 @@ func deliveryStreamDetail(ctx context.Context, meta schema.ClientMeta, resultsCh
 	}
 	resultsChan <- streamSummary.DeliveryStreamDescription
 }
+
+// isDescribeDeliveryStreamThrottled also treats LimitExceededException as throttling, which is how Firehose
+// reports that the DescribeDeliveryStream rate limit was exceeded.
+func isDescribeDeliveryStreamThrottled(err error) bool {
+	var ae smithy.APIError
+	return client.IsErrorThrottle(err) || (errors.As(err, &ae) && ae.ErrorCode() == "LimitExceededException")
+}
@@ -31,6 +31,8 @@ func buildKinesisFirehoses(t *testing.T, ctrl *gomock.Controller) client.Service
 		t.Fatal(err)
 	}
 	stream.DeliveryStreamDescription.Destinations = []types.DestinationDescription{stream.DeliveryStreamDescription.Destinations[0]}
+	stream.DeliveryStreamDescription.Source.KinesisStreamSourceDescription.KinesisStreamARN = aws.String("arn:aws:kinesis:us-east-1:123456789012:stream/test")
+	stream.DeliveryStreamDescription.Destinations[0].AmazonopensearchserviceDestinationDescription.IndexRotationPeriod = types.AmazonopensearchserviceIndexRotationPeriodOneDay
 
 	f.EXPECT().DescribeDeliveryStream(gomock.Any(), gomock.Any(), gomock.Any()).MinTimes(1).Return(&stream, nil)
 
@@ -0,0 +1,165 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	"github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/aws/smithy-go"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexRotationHours(t *testing.T) {
+	cases := []struct {
+		period types.AmazonopensearchserviceIndexRotationPeriod
+		want   *int
+	}{
+		{types.AmazonopensearchserviceIndexRotationPeriodNoRotation, aws.Int(0)},
+		{types.AmazonopensearchserviceIndexRotationPeriodOneHour, aws.Int(1)},
+		{types.AmazonopensearchserviceIndexRotationPeriodOneDay, aws.Int(24)},
+		{types.AmazonopensearchserviceIndexRotationPeriodOneWeek, aws.Int(168)},
+		{types.AmazonopensearchserviceIndexRotationPeriodOneMonth, aws.Int(720)},
+		{"", nil},
+		{"OneFortnight", nil},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.want, indexRotationHours(tc.period), "period %q", tc.period)
+	}
+}
+
+func TestResolveHasUnknownProcessorType(t *testing.T) {
+	cases := []struct {
+		item interface{}
+		want interface{}
+	}{
+		{&types.SplunkDestinationDescription{}, nil},
+		{&types.HttpEndpointDestinationDescription{ProcessingConfiguration: &types.ProcessingConfiguration{}}, false},
+		{&types.ExtendedS3DestinationDescription{ProcessingConfiguration: &types.ProcessingConfiguration{
+			Processors: []types.Processor{{Type: types.ProcessorTypeLambda}, {Type: types.ProcessorTypeAppendDelimiterToRecord}},
+		}}, false},
+		{&types.RedshiftDestinationDescription{ProcessingConfiguration: &types.ProcessingConfiguration{
+			Processors: []types.Processor{{Type: types.ProcessorTypeLambda}, {Type: "Cobol"}},
+		}}, true},
+	}
+	for _, tc := range cases {
+		table := &schema.Table{Columns: []schema.Column{hasUnknownProcessorTypeColumn}}
+		r := schema.NewResourceData(schema.PostgresDialect{}, table, nil, tc.item, nil, time.Now())
+		assert.NoError(t, hasUnknownProcessorTypeColumn.Resolver(context.Background(), nil, r, table.Columns[0]))
+		assert.Equal(t, tc.want, r.Get("has_unknown_processor_type"))
+	}
+}
+
+func TestFetchFirehoseDeliveryStreamProcessors(t *testing.T) {
+	lambda := types.Processor{
+		Type: types.ProcessorTypeLambda,
+		Parameters: []types.ProcessorParameter{
+			{ParameterName: types.ProcessorParameterNameLambdaArn, ParameterValue: aws.String("arn:aws:lambda:us-east-1:123456789012:function:transform")},
+			{ParameterName: types.ProcessorParameterNameBufferSizeInMb, ParameterValue: aws.String("3")},
+		},
+	}
+	stream := &types.DeliveryStreamDescription{
+		Destinations: []types.DestinationDescription{
+			{
+				DestinationId: aws.String("destinationId-000000000001"),
+				ExtendedS3DestinationDescription: &types.ExtendedS3DestinationDescription{
+					ProcessingConfiguration: &types.ProcessingConfiguration{Processors: []types.Processor{
+						{Type: types.ProcessorTypeRecordDeAggregation}, lambda,
+					}},
+				},
+			},
+			{
+				DestinationId:                aws.String("destinationId-000000000002"),
+				SplunkDestinationDescription: &types.SplunkDestinationDescription{},
+			},
+		},
+	}
+	parent := schema.NewResourceData(schema.PostgresDialect{}, &schema.Table{}, nil, stream, nil, time.Now())
+	res := make(chan interface{}, 10)
+	assert.NoError(t, fetchFirehoseDeliveryStreamProcessors(context.Background(), nil, parent, res))
+	close(res)
+	var processors []DeliveryStreamProcessor
+	for p := range res {
+		processors = append(processors, p.(DeliveryStreamProcessor))
+	}
+	assert.Len(t, processors, 2)
+	assert.Equal(t, "extended_s3", processors[1].DestinationType)
+	assert.Equal(t, 1, processors[1].Position)
+
+	table := &schema.Table{Columns: []schema.Column{{Name: "parameters", Type: schema.TypeJSON}}}
+	r := schema.NewResourceData(schema.PostgresDialect{}, table, nil, processors[1], nil, time.Now())
+	assert.NoError(t, resolveFirehoseDeliveryStreamProcessorParameters(context.Background(), nil, r, table.Columns[0]))
+	assert.Equal(t, map[string]interface{}{
+		"LambdaArn":       "arn:aws:lambda:us-east-1:123456789012:function:transform",
+		"BufferSizeInMBs": "3",
+	}, r.Get("parameters"))
+}
+
+func TestListDeliveryStreamsEmptyPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mocks.NewMockFirehoseClient(ctrl)
+	m.EXPECT().ListDeliveryStreams(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&firehose.ListDeliveryStreamsOutput{DeliveryStreamNames: []string{"stream1"}, HasMoreDeliveryStreams: aws.Bool(true)}, nil)
+	m.EXPECT().ListDeliveryStreams(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&firehose.ListDeliveryStreamsOutput{HasMoreDeliveryStreams: aws.Bool(true)}, nil)
+
+	c := client.NewAwsClient(hclog.NewNullLogger())
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "testAccount", "us-east-1", client.Services{Firehose: m})
+	c.Partition, c.AccountID, c.Region = "aws", "testAccount", "us-east-1"
+
+	names := make(chan interface{}, 10)
+	assert.NoError(t, listDeliveryStreams(context.Background(), &c, names))
+	close(names)
+	var got []interface{}
+	for n := range names {
+		got = append(got, n)
+	}
+	assert.Equal(t, []interface{}{"stream1"}, got)
+}
+
+func TestResolveEncryptionCustomerManaged(t *testing.T) {
+	cases := []struct {
+		config *types.DeliveryStreamEncryptionConfiguration
+		want   bool
+	}{
+		{nil, false},
+		{&types.DeliveryStreamEncryptionConfiguration{Status: types.DeliveryStreamEncryptionStatusEnabled, KeyType: types.KeyTypeAwsOwnedCmk}, false},
+		{&types.DeliveryStreamEncryptionConfiguration{Status: types.DeliveryStreamEncryptionStatusEnabling, KeyType: types.KeyTypeCustomerManagedCmk}, false},
+		{&types.DeliveryStreamEncryptionConfiguration{Status: types.DeliveryStreamEncryptionStatusEnabled, KeyType: types.KeyTypeCustomerManagedCmk}, true},
+	}
+	for _, tc := range cases {
+		table := &schema.Table{Columns: []schema.Column{{Name: "encryption_customer_managed", Type: schema.TypeBool}}}
+		item := &types.DeliveryStreamDescription{DeliveryStreamEncryptionConfiguration: tc.config}
+		r := schema.NewResourceData(schema.PostgresDialect{}, table, nil, item, nil, time.Now())
+		assert.NoError(t, resolveFirehoseDeliveryStreamEncryptionCustomerManaged(context.Background(), nil, r, table.Columns[0]))
+		assert.Equal(t, tc.want, r.Get("encryption_customer_managed"))
+	}
+}
+
+func TestDeliveryStreamDetailRetriesThrottling(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := mocks.NewMockFirehoseClient(ctrl)
+	gomock.InOrder(
+		m.EXPECT().DescribeDeliveryStream(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			nil, &smithy.GenericAPIError{Code: "LimitExceededException", Message: "Rate exceeded"}),
+		m.EXPECT().DescribeDeliveryStream(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&firehose.DescribeDeliveryStreamOutput{DeliveryStreamDescription: &types.DeliveryStreamDescription{DeliveryStreamName: aws.String("stream1")}}, nil),
+	)
+
+	c := client.NewAwsClient(hclog.NewNullLogger())
+	c.ServicesManager.InitServicesForPartitionAccountAndRegion("aws", "testAccount", "us-east-1", client.Services{Firehose: m})
+	c.Partition, c.AccountID, c.Region = "aws", "testAccount", "us-east-1"
+
+	results := make(chan interface{}, 1)
+	errs := make(chan error, 1)
+	deliveryStreamDetail(context.Background(), &c, results, errs, "stream1")
+	assert.Empty(t, errs)
+	assert.Equal(t, "stream1", aws.ToString((<-results).(*types.DeliveryStreamDescription).DeliveryStreamName))
+}
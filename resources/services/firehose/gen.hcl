@@ -55,6 +55,33 @@ resource "aws" "firehose" "delivery_streams" {
       params = ["DeliveryStreamARN"]
     }
   }
+  userDefinedColumn "is_untagged" {
+    type        = "bool"
+    description = "True when the delivery stream has no tags"
+    resolver "resolveUntagged" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveUntagged"
+    }
+  }
+  userDefinedColumn "max_buffering_interval_seconds" {
+    type              = "int"
+    description       = "The largest buffering interval, in seconds, across all destinations of the delivery stream. Destinations without buffering hints count as the default of 300 seconds"
+    generate_resolver = true
+  }
+  userDefinedColumn "source_cross_account" {
+    type              = "bool"
+    description       = "True when the source Kinesis data stream belongs to a different account than the delivery stream"
+    generate_resolver = true
+  }
+  userDefinedColumn "encryption_customer_managed" {
+    type              = "bool"
+    description       = "True when server-side encryption is enabled with a customer managed CMK"
+    generate_resolver = true
+  }
+  userDefinedColumn "destination_count" {
+    type              = "int"
+    description       = "The number of destinations of the delivery stream"
+    generate_resolver = true
+  }
   column "delivery_stream_encryption_configuration" {
     rename = "encryption_config"
   }
@@ -92,6 +119,23 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
+    userDefinedColumn "opensearch_public_delivery" {
+      type              = "bool"
+      description       = "True when the destination has no VPC configuration and data is delivered over the public internet"
+      generate_resolver = true
+    }
+    userDefinedColumn "index_rotation_hours" {
+      type              = "int"
+      description       = "The index rotation period in hours (0 for NoRotation)"
+      generate_resolver = true
+    }
   }
   user_relation "aws" "kinesis" "elasticsearch_destination" {
     path = "github.com/aws/aws-sdk-go-v2/service/firehose/types.ElasticsearchDestinationDescription"
@@ -117,6 +161,13 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
   }
   user_relation "aws" "kinesis" "extended_s3_destination" {
     path = "github.com/aws/aws-sdk-go-v2/service/firehose/types.ExtendedS3DestinationDescription"
@@ -157,6 +208,13 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
   }
   user_relation "aws" "kinesis" "http_destination" {
     path = "github.com/aws/aws-sdk-go-v2/service/firehose/types.HttpEndpointDestinationDescription"
@@ -182,6 +240,13 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
     column "request_configuration_common_attributes" {
       // skip = true
       type              = "json"
@@ -226,6 +291,13 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
   }
   user_relation "aws" "kinesis" "splunk_destination" {
     path = "github.com/aws/aws-sdk-go-v2/service/firehose/types.SplunkDestinationDescription"
@@ -252,5 +324,24 @@ resource "aws" "firehose" "delivery_streams" {
         params = ["ProcessingConfiguration.Processors"]
       }
     }
+    userDefinedColumn "has_unknown_processor_type" {
+      type        = "bool"
+      description = "True when a processor type isn't one of Lambda, MetadataExtraction, RecordDeAggregation or AppendDelimiterToRecord"
+      resolver "resolveHasUnknownProcessorType" {
+        path = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.resolveFirehoseDeliveryStreamDestinationHasUnknownProcessorType"
+      }
+    }
+  }
+  user_relation "aws" "firehose" "processors" {
+    path        = "github.com/cloudquery/cq-provider-aws/resources/services/firehose.DeliveryStreamProcessor"
+    description = "A data processor of a delivery stream destination"
+    column "parameters" {
+      skip = true
+    }
+    userDefinedColumn "parameters" {
+      type              = "json"
+      description       = "The processor parameters, keyed by parameter name"
+      generate_resolver = true
+    }
   }
 }
@@ -2,12 +2,15 @@ package guardduty
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+	"github.com/thoas/go-funk"
 )
 
 type Detector struct {
@@ -90,6 +93,24 @@ func GuarddutyDetectors() *schema.Table {
 				Type:        schema.TypeString,
 				Resolver:    schema.PathResolver("DataSources.S3Logs.Status"),
 			},
+			{
+				Name:        "s3_logs_enabled",
+				Description: "True if S3 data event logs are enabled as a data source for the detector.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveDetectorDataSourceEnabled("DataSources.S3Logs.Status"),
+			},
+			{
+				Name:        "kubernetes_audit_logs_enabled",
+				Description: "True if Kubernetes audit logs are enabled as a data source for the detector.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveDetectorDataSourceEnabled("DataSources.Kubernetes.AuditLogs.Status"),
+			},
+			{
+				Name:        "malware_protection_enabled",
+				Description: "True if GuardDuty-initiated EBS volume malware scans are enabled as a data source for the detector.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveDetectorDataSourceEnabled("DataSources.MalwareProtection.ScanEc2InstanceWithFindings.EbsVolumes.Status"),
+			},
 			{
 				Name:        "finding_publishing_frequency",
 				Description: "The publishing frequency of the finding.",
@@ -159,10 +180,90 @@ func GuarddutyDetectors() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:        "aws_guardduty_detector_findings",
+				Description: "Contains information about the finding, which is generated when abnormal or suspicious activity is detected.",
+				Resolver:    fetchGuarddutyDetectorFindings,
+				Columns: []schema.Column{
+					{
+						Name:        "detector_cq_id",
+						Description: "Unique CloudQuery ID of aws_guardduty_detectors table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "id",
+						Description: "The ID of the finding.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "arn",
+						Description: "The ARN of the finding.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "type",
+						Description: "The type of finding.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "severity",
+						Description: "The severity of the finding.",
+						Type:        schema.TypeFloat,
+					},
+					{
+						Name:        "confidence",
+						Description: "The confidence score for the finding.",
+						Type:        schema.TypeFloat,
+					},
+					{
+						Name:        "title",
+						Description: "The title of the finding.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "description",
+						Description: "The description of the finding.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "region",
+						Description: "The Region where the finding was generated.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "resource",
+						Description: "Contains information about the Amazon Web Services resource associated with the activity that prompted GuardDuty to generate a finding.",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveGuarddutyDetectorFindingJSONField(func(f types.Finding) interface{} { return f.Resource }),
+					},
+					{
+						Name:        "service",
+						Description: "Contains additional information about the generated finding.",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveGuarddutyDetectorFindingJSONField(func(f types.Finding) interface{} { return f.Service }),
+					},
+					{
+						Name:        "created_at",
+						Description: "The time and date when the finding was created.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.DateResolver("CreatedAt"),
+					},
+					{
+						Name:        "updated_at",
+						Description: "The time and date when the finding was last updated.",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.DateResolver("UpdatedAt"),
+					},
+				},
+			},
 		},
 	}
 }
 
+// maxFindingsPerRequest is the maximum number of finding IDs GetFindings accepts
+const maxFindingsPerRequest = 50
+
 // ====================================================================================================================
 //                                               Table Resolver Functions
 // ====================================================================================================================
@@ -209,3 +310,52 @@ func fetchGuarddutyDetectorMembers(ctx context.Context, meta schema.ClientMeta,
 		config.NextToken = output.NextToken
 	}
 }
+
+func fetchGuarddutyDetectorFindings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	detector := parent.Item.(Detector)
+	c := meta.(*client.Client)
+	svc := c.Services().GuardDuty
+	config := &guardduty.ListFindingsInput{DetectorId: aws.String(detector.Id), MaxResults: maxFindingsPerRequest}
+	for {
+		output, err := svc.ListFindings(ctx, config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for i := 0; i < len(output.FindingIds); i += maxFindingsPerRequest {
+			end := i + maxFindingsPerRequest
+			if end > len(output.FindingIds) {
+				end = len(output.FindingIds)
+			}
+			findings, err := svc.GetFindings(ctx, &guardduty.GetFindingsInput{
+				DetectorId: aws.String(detector.Id),
+				FindingIds: output.FindingIds[i:end],
+			})
+			if err != nil {
+				return diag.WrapError(err)
+			}
+			res <- findings.Findings
+		}
+		if aws.ToString(output.NextToken) == "" {
+			return nil
+		}
+		config.NextToken = output.NextToken
+	}
+}
+
+func resolveGuarddutyDetectorFindingJSONField(getter func(f types.Finding) interface{}) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		b, err := json.Marshal(getter(resource.Item.(types.Finding)))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
+
+// resolveDetectorDataSourceEnabled resolves a data source status of the detector to a bool, a missing data source counts as disabled
+func resolveDetectorDataSourceEnabled(path string) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		status, _ := funk.Get(resource.Item, path).(types.DataSourceStatus)
+		return diag.WrapError(resource.Set(c.Name, status == types.DataSourceStatusEnabled))
+	}
+}
@@ -40,6 +40,19 @@ func buildGuardDutyDetectors(t *testing.T, ctrl *gomock.Controller) client.Servi
 	m.EXPECT().ListMembers(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&guardduty.ListMembersOutput{Members: []gdTypes.Member{member}}, nil,
 	)
+	var finding gdTypes.Finding
+	if err := faker.FakeData(&finding); err != nil {
+		t.Fatal(err)
+	}
+	finding.CreatedAt = aws.String(time.Now().Format(time.RFC3339))
+	finding.UpdatedAt = aws.String(time.Now().Format(time.RFC3339))
+
+	m.EXPECT().ListFindings(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&guardduty.ListFindingsOutput{FindingIds: []string{aws.ToString(finding.Id)}}, nil,
+	)
+	m.EXPECT().GetFindings(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&guardduty.GetFindingsOutput{Findings: []gdTypes.Finding{finding}}, nil,
+	)
 	return client.Services{
 		GuardDuty: m,
 	}
@@ -46,7 +46,7 @@ func IamAccounts() *schema.Table {
 		Name:          "aws_accounts",
 		Description:   "Information about IAM entity usage and IAM quotas in the AWS account.",
 		Resolver:      fetchAccountSummary,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id"}},
@@ -201,7 +201,8 @@ func fetchAccountSummary(ctx context.Context, meta schema.ClientMeta, _ *schema.
 		return diag.WrapError(err)
 	}
 	var accSummary account
-	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{TagName: "json", WeaklyTypedInput: true, Result: &accSummary})
+	// SummaryMap keys are the PascalCase field names (e.g. "AccountMFAEnabled"), so match on field names rather than json tags
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{WeaklyTypedInput: true, Result: &accSummary})
 	if err != nil {
 		return diag.WrapError(err)
 	}
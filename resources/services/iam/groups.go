@@ -16,7 +16,7 @@ func IamGroups() *schema.Table {
 		Name:          "aws_iam_groups",
 		Description:   "Contains information about an IAM group entity.",
 		Resolver:      fetchIamGroups,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
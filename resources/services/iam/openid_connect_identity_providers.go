@@ -19,7 +19,7 @@ func IamOpenidConnectIdentityProviders() *schema.Table {
 		Name:          "aws_iam_openid_connect_identity_providers",
 		Description:   "IAM OIDC identity providers are entities in IAM that describe an external identity provider (IdP) service that supports the OpenID Connect (OIDC) standard, such as Google or Salesforce.",
 		Resolver:      fetchIamOpenidConnectIdentityProviders,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
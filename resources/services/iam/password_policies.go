@@ -20,7 +20,7 @@ func IamPasswordPolicies() *schema.Table {
 		Name:         "aws_iam_password_policies",
 		Description:  "Contains information about the account password policy.",
 		Resolver:     fetchIamPasswordPolicies,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id"}},
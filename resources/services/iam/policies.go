@@ -18,7 +18,7 @@ func IamPolicies() *schema.Table {
 		Name:         "aws_iam_policies",
 		Description:  "Contains information about a managed policy, including the policy's ARN, versions, and the number of principal entities (users, groups, and roles) that the policy is attached to.",
 		Resolver:     fetchIamPolicies,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
@@ -87,6 +87,12 @@ func IamPolicies() *schema.Table {
 				Description: "The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601), when the policy was last updated. When a policy has only one version, this field contains the date and time when the policy was created. When a policy has more than one version, this field contains the date and time when the most recent policy version was created. ",
 				Type:        schema.TypeTimestamp,
 			},
+			{
+				Name:        "default_version_document",
+				Description: "The decoded policy document of the policy's default version.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveIamPolicyDefaultVersionDocument,
+			},
 		},
 		Relations: []*schema.Table{
 			{
@@ -167,3 +173,21 @@ func resolveIamPolicyVersionDocument(ctx context.Context, meta schema.ClientMeta
 	}
 	return nil
 }
+func resolveIamPolicyDefaultVersionDocument(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(types.ManagedPolicyDetail)
+	for _, v := range r.PolicyVersionList {
+		if !v.IsDefaultVersion || v.Document == nil {
+			continue
+		}
+		decodedDocument, err := url.QueryUnescape(*v.Document)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		data := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(decodedDocument), &data); err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, data))
+	}
+	return nil
+}
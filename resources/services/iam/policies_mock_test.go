@@ -23,6 +23,7 @@ func buildIamPolicies(t *testing.T, ctrl *gomock.Controller) client.Services {
 	for i := range g.PolicyVersionList {
 		g.PolicyVersionList[i].Document = &document
 	}
+	g.PolicyVersionList[0].IsDefaultVersion = true
 
 	m.EXPECT().GetAccountAuthorizationDetails(gomock.Any(), gomock.Any()).Return(
 		&iam.GetAccountAuthorizationDetailsOutput{
@@ -17,7 +17,7 @@ func IamRoles() *schema.Table {
 		Name:         "aws_iam_roles",
 		Description:  "An IAM role is an IAM identity that you can create in your account that has specific permissions.",
 		Resolver:     fetchIamRoles,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
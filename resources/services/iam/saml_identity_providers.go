@@ -19,7 +19,7 @@ func IamSamlIdentityProviders() *schema.Table {
 		Name:         "aws_iam_saml_identity_providers",
 		Description:  "SAML provider resource objects defined in IAM for the AWS account.",
 		Resolver:     fetchIamSamlIdentityProviders,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
@@ -15,7 +15,7 @@ func IamServerCertificates() *schema.Table {
 		Name:          "aws_iam_server_certificates",
 		Description:   "Contains information about a server certificate without its certificate body, certificate chain, and private key.",
 		Resolver:      fetchIamServerCertificates,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
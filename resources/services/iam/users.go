@@ -55,7 +55,7 @@ func IamUsers() *schema.Table {
 	return &schema.Table{
 		Name:                 "aws_iam_users",
 		Resolver:             fetchIamUsers,
-		Multiplex:            client.AccountMultiplex,
+		Multiplex:            client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:          client.IgnoreCommonErrors,
 		DeleteFilter:         client.DeleteAccountFilter,
 		PostResourceResolver: postIamUserResolver,
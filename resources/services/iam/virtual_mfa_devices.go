@@ -15,7 +15,7 @@ func IamVirtualMfaDevices() *schema.Table {
 		Name:          "aws_iam_virtual_mfa_devices",
 		Description:   "Contains information about a virtual MFA device.",
 		Resolver:      fetchIamVirtualMfaDevices,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("iam"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"serial_number"}},
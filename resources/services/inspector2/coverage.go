@@ -0,0 +1,122 @@
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+//go:generate cq-gen --resource coverage --config gen.hcl --output .
+func Coverage() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_inspector2_coverage",
+		Description:  "An object that contains details about a resource covered by Amazon Inspector",
+		Resolver:     fetchInspector2Coverage,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("inspector2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "region", "resource_id", "scan_type"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The Amazon Web Services account ID of the covered resource",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "resource_id",
+				Description: "The ID of the covered resource",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "resource_type",
+				Description: "The type of the covered resource",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "scan_type",
+				Description: "The Amazon Inspector scan type covering the resource",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "resource_metadata_ec2_ami_id",
+				Description: "The ID of the Amazon Machine Image (AMI) used to launch the instance",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ResourceMetadata.Ec2.AmiId"),
+			},
+			{
+				Name:        "resource_metadata_ec2_platform",
+				Description: "The platform of the instance",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ResourceMetadata.Ec2.Platform"),
+			},
+			{
+				Name:        "resource_metadata_ec2_tags",
+				Description: "The tags attached to the instance",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ResourceMetadata.Ec2.Tags"),
+			},
+			{
+				Name:        "resource_metadata_ecr_image_tags",
+				Description: "Tags associated with the Amazon ECR image metadata",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("ResourceMetadata.EcrImage.Tags"),
+			},
+			{
+				Name:        "resource_metadata_ecr_repository_name",
+				Description: "The name of the Amazon ECR repository",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ResourceMetadata.EcrRepository.Name"),
+			},
+			{
+				Name:        "resource_metadata_ecr_repository_scan_frequency",
+				Description: "The frequency of scans",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ResourceMetadata.EcrRepository.ScanFrequency"),
+			},
+			{
+				Name:        "scan_status_reason",
+				Description: "The reason for the scan",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ScanStatus.Reason"),
+			},
+			{
+				Name:        "scan_status_code",
+				Description: "The status code of the scan",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ScanStatus.StatusCode"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchInspector2Coverage(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().InspectorV2
+	input := inspector2.ListCoverageInput{MaxResults: aws.Int32(200)}
+	for {
+		response, err := svc.ListCoverage(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.CoveredResources
+		if aws.ToString(response.NextToken) == "" {
+			break
+		}
+		input.NextToken = response.NextToken
+	}
+	return nil
+}
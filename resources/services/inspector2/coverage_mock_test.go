@@ -0,0 +1,35 @@
+package inspector2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildInspectorV2Coverage(t *testing.T, ctrl *gomock.Controller) client.Services {
+	inspectorClient := mocks.NewMockInspectorV2Client(ctrl)
+
+	resource := types.CoveredResource{}
+	err := faker.FakeData(&resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inspectorClient.EXPECT().ListCoverage(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&inspector2.ListCoverageOutput{CoveredResources: []types.CoveredResource{resource}},
+		nil,
+	)
+
+	return client.Services{
+		InspectorV2: inspectorClient,
+	}
+}
+
+func TestInspectorV2Coverage(t *testing.T) {
+	client.AwsMockTestHelper(t, Coverage(), buildInspectorV2Coverage, client.TestOptions{})
+}
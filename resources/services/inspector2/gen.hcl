@@ -81,4 +81,39 @@ resource "aws" "inspector2" "findings" {
       type   = "json"
     }
   }
-}
\ No newline at end of file
+}
+
+resource "aws" "inspector2" "coverage" {
+  path = "github.com/aws/aws-sdk-go-v2/service/inspector2/types.CoveredResource"
+  ignoreError "IgnoreCommonErrors" {
+    path = "github.com/cloudquery/cq-provider-aws/client.IgnoreCommonErrors"
+  }
+  multiplex "AwsAccountRegion" {
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountRegionMultiplexer"
+    params = ["inspector2"]
+  }
+  deleteFilter "AccountRegionFilter" {
+    path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountRegionFilter"
+  }
+
+  options {
+    primary_keys = [
+      "account_id",
+      "region",
+      "resource_id",
+      "scan_type"
+    ]
+  }
+
+  userDefinedColumn "region" {
+    type        = "string"
+    description = "The AWS Region of the resource"
+    resolver "resolveAWSRegion" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSRegion"
+    }
+  }
+
+  column "resource_metadata_ec2_tags" {
+    type = "json"
+  }
+}
@@ -49,6 +49,21 @@ resource "aws" "kinesis" "streams" {
     type              = "json"
     generate_resolver = true
   }
+  user_relation "aws" "kinesis" "consumers" {
+    path = "github.com/aws/aws-sdk-go-v2/service/kinesis/types.Consumer"
+    column "consumer_a_r_n" {
+      rename = "arn"
+    }
+    column "consumer_creation_timestamp" {
+      rename = "creation_timestamp"
+    }
+    column "consumer_name" {
+      rename = "name"
+    }
+    column "consumer_status" {
+      rename = "status"
+    }
+  }
 }
 
 resource "aws" "kinesis" "firehoses" {
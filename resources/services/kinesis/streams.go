@@ -115,6 +115,43 @@ func Streams() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:        "aws_kinesis_stream_consumers",
+				Description: "An object that represents the details of a registered consumer",
+				Resolver:    fetchKinesisStreamConsumers,
+				Columns: []schema.Column{
+					{
+						Name:        "stream_cq_id",
+						Description: "Unique CloudQuery ID of aws_kinesis_streams table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "When you register a consumer, Kinesis Data Streams generates an ARN for it",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ConsumerARN"),
+					},
+					{
+						Name:        "creation_timestamp",
+						Description: "The time at which the consumer was registered",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.PathResolver("ConsumerCreationTimestamp"),
+					},
+					{
+						Name:        "name",
+						Description: "The name of the consumer is something you choose when you register the consumer",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ConsumerName"),
+					},
+					{
+						Name:        "status",
+						Description: "A consumer can't read data while in the CREATING or DELETING states",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("ConsumerStatus"),
+					},
+				},
+			},
 		},
 	}
 }
@@ -147,6 +184,25 @@ func ResolveKinesisStreamTags(ctx context.Context, meta schema.ClientMeta, resou
 	}
 	return diag.WrapError(resource.Set(c.Name, client.TagsToMap(tags)))
 }
+func fetchKinesisStreamConsumers(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Kinesis
+	stream := parent.Item.(*types.StreamDescriptionSummary)
+	input := kinesis.ListStreamConsumersInput{
+		StreamARN: stream.StreamARN,
+	}
+	for {
+		output, err := svc.ListStreamConsumers(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.Consumers
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 
 // ====================================================================================================================
 //                                                  User Defined Helpers
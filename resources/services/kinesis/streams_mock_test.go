@@ -69,6 +69,13 @@ func buildKinesisStreams(t *testing.T, ctrl *gomock.Controller) client.Services
 	tags.HasMoreTags = aws.Bool(false)
 	k.EXPECT().ListTagsForStream(gomock.Any(), gomock.Any(), gomock.Any()).MinTimes(1).Return(&tags, nil)
 
+	consumers := kinesis.ListStreamConsumersOutput{}
+	err = faker.FakeData(&consumers.Consumers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.EXPECT().ListStreamConsumers(gomock.Any(), gomock.Any(), gomock.Any()).MinTimes(1).Return(&consumers, nil)
+
 	return client.Services{
 		Kinesis: k,
 	}
@@ -47,6 +47,12 @@ func Keys() *schema.Table {
 				Type:        schema.TypeJSON,
 				Resolver:    resolveKeysTags,
 			},
+			{
+				Name:        "policy",
+				Description: "The default key policy attached to the KMS key.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveKeysPolicy,
+			},
 			{
 				Name:        "id",
 				Description: "The globally unique identifier for the KMS key.",
@@ -190,6 +196,100 @@ func Keys() *schema.Table {
 				IgnoreInTests: true,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_kms_key_grants",
+				Description: "Contains information about a grant on a KMS key.",
+				Resolver:    fetchKmsKeyGrants,
+				Columns: []schema.Column{
+					{
+						Name:        "key_cq_id",
+						Description: "Unique CloudQuery ID of aws_kms_keys table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "grant_id",
+						Description: "The unique identifier for the grant.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "name",
+						Description: "The friendly name that identifies the grant.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "creation_date",
+						Description: "The date and time when the grant was created.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "grantee_principal",
+						Description: "The identity that gets the permissions in the grant.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "retiring_principal",
+						Description: "The principal that can retire the grant.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "issuing_account",
+						Description: "The Amazon Web Services account under which the grant was issued.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "operations",
+						Description: "The list of operations permitted by the grant.",
+						Type:        schema.TypeStringArray,
+					},
+					{
+						Name:        "constraints",
+						Description: "A list of key-value pairs that must be present in the encryption context of certain subsequent operations that the grant allows.",
+						Type:        schema.TypeJSON,
+						Resolver:    resolveKeyGrantsConstraints,
+					},
+				},
+			},
+			{
+				Name:        "aws_kms_aliases",
+				Description: "Contains information about an alias of a KMS key.",
+				Resolver:    fetchKmsAliases,
+				Columns: []schema.Column{
+					{
+						Name:        "key_cq_id",
+						Description: "Unique CloudQuery ID of aws_kms_keys table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "alias_name",
+						Description: "String that contains the alias. This value begins with alias/.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "alias_arn",
+						Description: "String that contains the key ARN.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "target_key_id",
+						Description: "String that contains the key identifier of the KMS key associated with the alias.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "creation_date",
+						Description: "Date and time that the alias was most recently created in the account and Region.",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "last_updated_date",
+						Description: "Date and time that the alias was most recently associated with a KMS key in the account and Region.",
+						Type:        schema.TypeTimestamp,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -275,3 +375,68 @@ func resolveKeysRotationEnabled(ctx context.Context, meta schema.ClientMeta, res
 	}
 	return diag.WrapError(resource.Set(c.Name, result.KeyRotationEnabled))
 }
+func resolveKeysPolicy(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	svc := cl.Services().KMS
+	key := resource.Item.(types.KeyMetadata)
+	result, err := svc.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{KeyId: key.KeyId, PolicyName: aws.String("default")})
+	if err != nil {
+		if cl.IsNotFoundError(err) {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if result.Policy == nil {
+		return nil
+	}
+	var policy map[string]interface{}
+	if err := json.Unmarshal([]byte(*result.Policy), &policy); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, policy))
+}
+func fetchKmsKeyGrants(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	key := parent.Item.(types.KeyMetadata)
+	svc := meta.(*client.Client).Services().KMS
+	input := kms.ListGrantsInput{KeyId: key.KeyId}
+	for {
+		response, err := svc.ListGrants(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.Grants
+		if aws.ToString(response.NextMarker) == "" {
+			break
+		}
+		input.Marker = response.NextMarker
+	}
+	return nil
+}
+func resolveKeyGrantsConstraints(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	grant := resource.Item.(types.GrantListEntry)
+	if grant.Constraints == nil {
+		return nil
+	}
+	b, err := json.Marshal(grant.Constraints)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
+func fetchKmsAliases(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	key := parent.Item.(types.KeyMetadata)
+	svc := meta.(*client.Client).Services().KMS
+	input := kms.ListAliasesInput{KeyId: key.KeyId}
+	for {
+		response, err := svc.ListAliases(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.Aliases
+		if aws.ToString(response.NextMarker) == "" {
+			break
+		}
+		input.Marker = response.NextMarker
+	}
+	return nil
+}
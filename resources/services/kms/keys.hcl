@@ -65,4 +65,27 @@ resource "aws" "kms" "keys" {
       type = "json"
       generate_resolver = true
   }
+
+  userDefinedColumn "policy" {
+      description = "The default key policy attached to the KMS key."
+      type = "json"
+      generate_resolver = true
+  }
+
+  user_relation "aws" "kms" "grants" {
+    path = "github.com/aws/aws-sdk-go-v2/service/kms/types.GrantListEntry"
+
+    column "key_id" {
+      skip = true
+    }
+
+    column "constraints" {
+      type = "json"
+      generate_resolver = true
+    }
+  }
+
+  user_relation "aws" "kms" "aliases" {
+    path = "github.com/aws/aws-sdk-go-v2/service/kms/types.AliasListEntry"
+  }
 }
@@ -3,6 +3,7 @@ package kms
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-aws/client/mocks"
@@ -47,6 +48,27 @@ func buildKmsKeys(t *testing.T, ctrl *gomock.Controller) client.Services {
 	m.EXPECT().GetKeyRotationStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&rotation, nil)
 
+	m.EXPECT().GetKeyPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&kms.GetKeyPolicyOutput{Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`)}, nil)
+
+	grants := kms.ListGrantsOutput{}
+	err = faker.FakeData(&grants)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grants.NextMarker = nil
+	m.EXPECT().ListGrants(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&grants, nil)
+
+	aliases := kms.ListAliasesOutput{}
+	err = faker.FakeData(&aliases)
+	if err != nil {
+		t.Fatal(err)
+	}
+	aliases.NextMarker = nil
+	m.EXPECT().ListAliases(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&aliases, nil)
+
 	return client.Services{
 		KMS: m,
 	}
@@ -940,6 +940,52 @@ func Functions() *schema.Table {
 					},
 				},
 			},
+			{
+				Name:        "aws_lambda_function_url_configs",
+				Description: "Details about a Lambda function URL.",
+				Resolver:    fetchLambdaFunctionUrlConfigs,
+				Columns: []schema.Column{
+					{
+						Name:        "function_cq_id",
+						Description: "Unique CloudQuery ID of aws_lambda_functions table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "function_arn",
+						Description: "The Amazon Resource Name (ARN) of your function.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "function_url",
+						Description: "The HTTP URL endpoint for your function.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "auth_type",
+						Description: "The type of authentication that your function URL uses. Set to NONE if you want to bypass IAM authentication to create a public endpoint.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "cors",
+						Description: "The cross-origin resource sharing (CORS) (https://developer.mozilla.org/en-US/docs/Web/HTTP/CORS) settings for your function URL.",
+						Type:        schema.TypeJSON,
+						Resolver:    schema.PathResolver("Cors"),
+					},
+					{
+						Name:        "creation_time",
+						Description: "When the function URL was created, in ISO-8601 format (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.DateResolver("CreationTime"),
+					},
+					{
+						Name:        "last_modified_time",
+						Description: "When the function URL configuration was last updated, in ISO-8601 format (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).",
+						Type:        schema.TypeTimestamp,
+						Resolver:    schema.DateResolver("LastModifiedTime"),
+					},
+				},
+			},
 			{
 				Name:          "aws_lambda_function_event_source_mappings",
 				Description:   "A mapping between an Amazon Web Services resource and a Lambda function",
@@ -1344,6 +1390,34 @@ func fetchLambdaFunctionConcurrencyConfigs(ctx context.Context, meta schema.Clie
 	}
 	return nil
 }
+func fetchLambdaFunctionUrlConfigs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	p := parent.Item.(*lambda.GetFunctionOutput)
+	if p.Configuration == nil {
+		return nil
+	}
+
+	cl := meta.(*client.Client)
+	svc := cl.Services().Lambda
+	config := lambda.ListFunctionUrlConfigsInput{
+		FunctionName: p.Configuration.FunctionName,
+	}
+
+	for {
+		output, err := svc.ListFunctionUrlConfigs(ctx, &config)
+		if err != nil {
+			if cl.IsNotFoundError(err) {
+				return nil
+			}
+			return diag.WrapError(err)
+		}
+		res <- output.FunctionUrlConfigs
+		if output.NextMarker == nil {
+			break
+		}
+		config.Marker = output.NextMarker
+	}
+	return nil
+}
 func fetchLambdaFunctionEventSourceMappings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
 	p := parent.Item.(*lambda.GetFunctionOutput)
 	if p.Configuration == nil {
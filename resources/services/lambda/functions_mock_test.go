@@ -122,6 +122,18 @@ func buildLambdaFunctionsMock(t *testing.T, ctrl *gomock.Controller) client.Serv
 	m.EXPECT().GetFunctionUrlConfig(gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		&urlConfig, nil)
 
+	functionUrlConfig := types.FunctionUrlConfig{}
+	err = faker.FakeData(&functionUrlConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	functionUrlConfig.CreationTime = aws.String("2012-07-14T01:00:00+01:00")
+	functionUrlConfig.LastModifiedTime = aws.String("2012-07-14T01:00:00+01:00")
+	m.EXPECT().ListFunctionUrlConfigs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&lambda.ListFunctionUrlConfigsOutput{
+			FunctionUrlConfigs: []types.FunctionUrlConfig{functionUrlConfig},
+		}, nil)
+
 	return client.Services{
 		Lambda: m,
 	}
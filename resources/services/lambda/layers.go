@@ -38,6 +38,12 @@ func LambdaLayers() *schema.Table {
 				Type:        schema.TypeString,
 				Resolver:    client.ResolveAWSRegion,
 			},
+			{
+				Name:        "latest_matching_version_compatible_architectures",
+				Description: "A list of compatible instruction set architectures (https://docs.aws.amazon.com/lambda/latest/dg/foundation-arch.html).",
+				Type:        schema.TypeStringArray,
+				Resolver:    schema.PathResolver("LatestMatchingVersion.CompatibleArchitectures"),
+			},
 			{
 				Name:        "latest_matching_version_compatible_runtimes",
 				Description: "The layer's compatible runtimes.",
@@ -100,6 +106,11 @@ func LambdaLayers() *schema.Table {
 						Type:        schema.TypeUUID,
 						Resolver:    schema.ParentIdResolver,
 					},
+					{
+						Name:        "compatible_architectures",
+						Description: "A list of compatible instruction set architectures (https://docs.aws.amazon.com/lambda/latest/dg/foundation-arch.html).",
+						Type:        schema.TypeStringArray,
+					},
 					{
 						Name:        "compatible_runtimes",
 						Description: "The layer's compatible runtimes.",
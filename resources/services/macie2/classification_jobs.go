@@ -0,0 +1,134 @@
+package macie2
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func ClassificationJobs() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_macie2_classification_jobs",
+		Description:  "Provides information about a classification job, including the current status of the job.",
+		Resolver:     fetchMacie2ClassificationJobs,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("macie2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the job.",
+				Type:        schema.TypeString,
+				Resolver: client.ResolveARN(client.Macie2Service, func(resource *schema.Resource) ([]string, error) {
+					return []string{"classification-job", aws.ToString(resource.Item.(types.JobSummary).JobId)}, nil
+				}),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the job.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("JobId"),
+			},
+			{
+				Name:        "name",
+				Description: "The custom name of the job.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "job_status",
+				Description: "The current status of the job, such as RUNNING, PAUSED, CANCELLED, COMPLETE or IDLE.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "job_type",
+				Description: "The schedule for running the job, ONE_TIME or SCHEDULED.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the job was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "last_run_error_status_code",
+				Description: "Specifies whether any account- or bucket-level access errors occurred when the job ran, ERROR or NONE.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("LastRunErrorStatus.Code"),
+			},
+			{
+				Name:        "user_paused_details_job_paused_at",
+				Description: "The date and time when you paused the job.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("UserPausedDetails.JobPausedAt"),
+			},
+			{
+				Name:        "user_paused_details_job_expires_at",
+				Description: "The date and time when the job will expire and be cancelled if you don't resume it first.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.PathResolver("UserPausedDetails.JobExpiresAt"),
+			},
+			{
+				Name:        "bucket_definitions",
+				Description: "The S3 buckets that the job is configured to analyze, if the list of buckets is specified explicitly.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveMacie2ClassificationJobJSONField(func(j types.JobSummary) interface{} { return j.BucketDefinitions }),
+			},
+			{
+				Name:        "bucket_criteria",
+				Description: "The property- and tag-based conditions that determine which S3 buckets are included or excluded from the job's analysis.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveMacie2ClassificationJobJSONField(func(j types.JobSummary) interface{} { return j.BucketCriteria }),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchMacie2ClassificationJobs(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Macie2
+	input := macie2.ListClassificationJobsInput{}
+	for {
+		output, err := svc.ListClassificationJobs(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.Items
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveMacie2ClassificationJobJSONField(getter func(j types.JobSummary) interface{}) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		b, err := json.Marshal(getter(resource.Item.(types.JobSummary)))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
@@ -0,0 +1,33 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildMacie2ClassificationJobs(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockMacie2Client(ctrl)
+
+	var job types.JobSummary
+	if err := faker.FakeData(&job); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListClassificationJobs(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&macie2.ListClassificationJobsOutput{Items: []types.JobSummary{job}},
+		nil,
+	)
+
+	return client.Services{
+		Macie2: m,
+	}
+}
+
+func TestMacie2ClassificationJobs(t *testing.T) {
+	client.AwsMockTestHelper(t, ClassificationJobs(), buildMacie2ClassificationJobs, client.TestOptions{})
+}
@@ -0,0 +1,155 @@
+package macie2
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+// maxFindingsPerRequest is the maximum number of finding IDs GetFindings accepts
+const maxFindingsPerRequest = 40
+
+func Findings() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_macie2_findings",
+		Description:  "Provides the details of a finding.",
+		Resolver:     fetchMacie2Findings,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("macie2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "region", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The unique identifier for the Amazon Web Services account that the finding applies to.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "region",
+				Description: "The Amazon Web Services Region that Amazon Macie created the finding in.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier for the finding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the finding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "category",
+				Description: "The category of the finding, CLASSIFICATION for a sensitive data finding or POLICY for a policy finding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "severity_description",
+				Description: "The qualitative representation of the finding's severity, Low, Medium or High.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("Severity.Description"),
+			},
+			{
+				Name:        "severity_score",
+				Description: "The numerical representation of the finding's severity, ranging from 1 (least severe) to 3 (most severe).",
+				Type:        schema.TypeBigInt,
+				Resolver:    schema.PathResolver("Severity.Score"),
+			},
+			{
+				Name:        "title",
+				Description: "The brief description of the finding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the finding.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "count",
+				Description: "The total number of occurrences of the finding.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "archived",
+				Description: "Specifies whether the finding is archived (suppressed).",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "sample",
+				Description: "Specifies whether the finding is a sample finding.",
+				Type:        schema.TypeBool,
+			},
+			{
+				Name:        "resources_affected",
+				Description: "The resources that the finding applies to.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveMacie2FindingJSONField(func(f types.Finding) interface{} { return f.ResourcesAffected }),
+			},
+			{
+				Name:        "classification_details",
+				Description: "The details of a sensitive data finding. This value is null for a policy finding.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveMacie2FindingJSONField(func(f types.Finding) interface{} { return f.ClassificationDetails }),
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when Amazon Macie created the finding.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time when Amazon Macie last updated the finding.",
+				Type:        schema.TypeTimestamp,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchMacie2Findings(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Macie2
+	input := macie2.ListFindingsInput{MaxResults: maxFindingsPerRequest}
+	for {
+		output, err := svc.ListFindings(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for i := 0; i < len(output.FindingIds); i += maxFindingsPerRequest {
+			end := i + maxFindingsPerRequest
+			if end > len(output.FindingIds) {
+				end = len(output.FindingIds)
+			}
+			findings, err := svc.GetFindings(ctx, &macie2.GetFindingsInput{FindingIds: output.FindingIds[i:end]})
+			if err != nil {
+				return diag.WrapError(err)
+			}
+			res <- findings.Findings
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveMacie2FindingJSONField(getter func(f types.Finding) interface{}) schema.ColumnResolver {
+	return func(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+		b, err := json.Marshal(getter(resource.Item.(types.Finding)))
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		return diag.WrapError(resource.Set(c.Name, b))
+	}
+}
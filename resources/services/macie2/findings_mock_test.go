@@ -0,0 +1,38 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/aws/aws-sdk-go-v2/service/macie2/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildMacie2Findings(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockMacie2Client(ctrl)
+
+	var finding types.Finding
+	if err := faker.FakeData(&finding); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListFindings(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&macie2.ListFindingsOutput{FindingIds: []string{aws.ToString(finding.Id)}},
+		nil,
+	)
+	m.EXPECT().GetFindings(gomock.Any(), &macie2.GetFindingsInput{FindingIds: []string{aws.ToString(finding.Id)}}, gomock.Any()).Return(
+		&macie2.GetFindingsOutput{Findings: []types.Finding{finding}},
+		nil,
+	)
+
+	return client.Services{
+		Macie2: m,
+	}
+}
+
+func TestMacie2Findings(t *testing.T) {
+	client.AwsMockTestHelper(t, Findings(), buildMacie2Findings, client.TestOptions{})
+}
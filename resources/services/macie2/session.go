@@ -0,0 +1,77 @@
+package macie2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func Session() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_macie2_session",
+		Description:  "The status and configuration settings for an Amazon Macie account.",
+		Resolver:     fetchMacie2Session,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("macie2"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "region"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the Amazon Macie account, ENABLED or PAUSED.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "finding_publishing_frequency",
+				Description: "The frequency with which Amazon Macie publishes updates to policy findings for the account.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "service_role",
+				Description: "The Amazon Resource Name (ARN) of the service-linked role that allows Amazon Macie to monitor and analyze data in Amazon Web Services resources for the account.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "created_at",
+				Description: "The date and time when the Amazon Macie account was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "updated_at",
+				Description: "The date and time of the most recent change to the status of the Amazon Macie account.",
+				Type:        schema.TypeTimestamp,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+// fetchMacie2Session returns the Macie session of the account in the region. Macie returns an access denied error
+// when it isn't enabled, so those regions have no row.
+func fetchMacie2Session(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Macie2
+	output, err := svc.GetMacieSession(ctx, &macie2.GetMacieSessionInput{})
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	res <- output
+	return nil
+}
@@ -0,0 +1,29 @@
+package macie2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/macie2"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildMacie2Session(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockMacie2Client(ctrl)
+
+	var session macie2.GetMacieSessionOutput
+	if err := faker.FakeData(&session); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().GetMacieSession(gomock.Any(), gomock.Any(), gomock.Any()).Return(&session, nil)
+
+	return client.Services{
+		Macie2: m,
+	}
+}
+
+func TestMacie2Session(t *testing.T) {
+	client.AwsMockTestHelper(t, Session(), buildMacie2Session, client.TestOptions{})
+}
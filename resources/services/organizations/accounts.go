@@ -19,7 +19,7 @@ func Accounts() *schema.Table {
 		Name:          "aws_organizations_accounts",
 		Description:   "Contains information about an AWS account that is a member of an organization",
 		Resolver:      fetchOrganizationsAccounts,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("organizations"),
 		DeleteFilter:  client.DeleteAccountFilter,
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		IgnoreInTests: true,
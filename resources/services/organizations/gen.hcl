@@ -13,7 +13,8 @@ resource "aws" "organizations" "accounts" {
   }
 
   multiplex "AccountMultiplexer" {
-    path = "github.com/cloudquery/cq-provider-aws/client.AccountMultiplex"
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["organizations"]
   }
 
   deleteFilter "DeleteAccountFilter" {
@@ -37,4 +38,44 @@ resource "aws" "organizations" "accounts" {
     description       = "The AWS tags of the resource."
     generate_resolver = true
   }
-}
\ No newline at end of file
+}
+resource "aws" "organizations" "policies" {
+  path = "github.com/aws/aws-sdk-go-v2/service/organizations/types.Policy"
+  ignoreError "IgnoreAccessDenied" {
+    path = "github.com/cloudquery/cq-provider-aws/client.IgnoreCommonErrors"
+  }
+
+  multiplex "AccountMultiplexer" {
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["organizations"]
+  }
+
+  deleteFilter "DeleteAccountFilter" {
+    path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountFilter"
+  }
+
+  options {
+    primary_keys = ["account_id", "id"]
+  }
+
+  userDefinedColumn "account_id" {
+    type        = "string"
+    description = "The AWS Account ID of the resource."
+    resolver "resolveAWSAccount" {
+      path = "github.com/cloudquery/cq-provider-aws/client.ResolveAWSAccount"
+    }
+  }
+
+  column "content" {
+    type              = "json"
+    generate_resolver = true
+  }
+
+  column "policy_summary" {
+    skip_prefix = true
+  }
+
+  user_relation "aws" "organizations" "targets" {
+    path = "github.com/aws/aws-sdk-go-v2/service/organizations/types.PolicyTargetSummary"
+  }
+}
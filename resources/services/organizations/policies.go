@@ -0,0 +1,180 @@
+package organizations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/aws/smithy-go"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+//go:generate cq-gen --resource policies --config gen.hcl --output .
+func Policies() *schema.Table {
+	return &schema.Table{
+		Name:          "aws_organizations_policies",
+		Description:   "Contains rules to be applied to the affected accounts",
+		Resolver:      fetchOrganizationsPolicies,
+		Multiplex:     client.ServiceAccountMultiplexer("organizations"),
+		DeleteFilter:  client.DeleteAccountFilter,
+		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
+		IgnoreInTests: true,
+		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "content",
+				Description: "The text content of the policy",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveOrganizationsPolicyContent,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the policy",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PolicySummary.Arn"),
+			},
+			{
+				Name:        "aws_managed",
+				Description: "A boolean value that indicates whether the specified policy is an Amazon Web Services managed policy",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("PolicySummary.AwsManaged"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the policy",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PolicySummary.Description"),
+			},
+			{
+				Name:        "id",
+				Description: "The unique identifier (ID) of the policy",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PolicySummary.Id"),
+			},
+			{
+				Name:        "name",
+				Description: "The friendly name of the policy",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PolicySummary.Name"),
+			},
+			{
+				Name:        "type",
+				Description: "The type of policy",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("PolicySummary.Type"),
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_organizations_policy_targets",
+				Description: "Contains information about a root, OU, or account that a policy is attached to",
+				Resolver:    fetchOrganizationsPolicyTargets,
+				Columns: []schema.Column{
+					{
+						Name:        "policy_cq_id",
+						Description: "Unique CloudQuery ID of aws_organizations_policies table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "The Amazon Resource Name (ARN) of the policy target",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "name",
+						Description: "The friendly name of the policy target",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "target_id",
+						Description: "The unique identifier (ID) of the policy target",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "type",
+						Description: "The type of the policy target: ROOT, ORGANIZATIONAL_UNIT or ACCOUNT",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchOrganizationsPolicies(ctx context.Context, meta schema.ClientMeta, _ *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().Organizations
+	for _, policyType := range types.PolicyType("").Values() {
+		input := organizations.ListPoliciesInput{Filter: policyType}
+		for {
+			response, err := svc.ListPolicies(ctx, &input)
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				switch ae.ErrorCode() {
+				case "AWSOrganizationsNotInUseException", "AccessDeniedException":
+					// Same as for accounts: policies can only be listed from the organization's management account
+					meta.Logger().Warn("account is probably not the root organization account https://docs.aws.amazon.com/organizations/latest/APIReference/API_ListPolicies.html")
+					return nil
+				}
+			}
+			if err != nil {
+				return diag.WrapError(err)
+			}
+			for _, p := range response.Policies {
+				output, err := svc.DescribePolicy(ctx, &organizations.DescribePolicyInput{PolicyId: p.Id})
+				if err != nil {
+					return diag.WrapError(err)
+				}
+				res <- output.Policy
+			}
+			if aws.ToString(response.NextToken) == "" {
+				break
+			}
+			input.NextToken = response.NextToken
+		}
+	}
+	return nil
+}
+func resolveOrganizationsPolicyContent(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	policy := resource.Item.(*types.Policy)
+	if policy.Content == nil {
+		return nil
+	}
+	var content map[string]interface{}
+	if err := json.Unmarshal([]byte(*policy.Content), &content); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, content))
+}
+func fetchOrganizationsPolicyTargets(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	policy := parent.Item.(*types.Policy)
+	svc := meta.(*client.Client).Services().Organizations
+	input := organizations.ListTargetsForPolicyInput{PolicyId: policy.PolicySummary.Id}
+	for {
+		response, err := svc.ListTargetsForPolicy(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.Targets
+		if aws.ToString(response.NextToken) == "" {
+			break
+		}
+		input.NextToken = response.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package organizations
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	organizationsTypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildOrganizationsPolicies(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockOrganizationsClient(ctrl)
+	policyTypes := len(organizationsTypes.PolicyType("").Values())
+
+	var summary organizationsTypes.PolicySummary
+	if err := faker.FakeData(&summary); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListPolicies(gomock.Any(), gomock.Any(), gomock.Any()).Times(policyTypes).Return(
+		&organizations.ListPoliciesOutput{
+			Policies: []organizationsTypes.PolicySummary{summary},
+		}, nil)
+
+	m.EXPECT().DescribePolicy(gomock.Any(), &organizations.DescribePolicyInput{PolicyId: summary.Id}, gomock.Any()).Times(policyTypes).Return(
+		&organizations.DescribePolicyOutput{
+			Policy: &organizationsTypes.Policy{
+				PolicySummary: &summary,
+				Content:       aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`),
+			},
+		}, nil)
+
+	var target organizationsTypes.PolicyTargetSummary
+	if err := faker.FakeData(&target); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListTargetsForPolicy(gomock.Any(), &organizations.ListTargetsForPolicyInput{PolicyId: summary.Id}, gomock.Any()).Times(policyTypes).Return(
+		&organizations.ListTargetsForPolicyOutput{
+			Targets: []organizationsTypes.PolicyTargetSummary{target},
+		}, nil)
+
+	return client.Services{
+		Organizations: m,
+	}
+}
+
+func TestOrganizationsPolicies(t *testing.T) {
+	client.AwsMockTestHelper(t, Policies(), buildOrganizationsPolicies, client.TestOptions{})
+}
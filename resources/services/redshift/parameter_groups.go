@@ -0,0 +1,179 @@
+package redshift
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func ParameterGroups() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_redshift_parameter_groups",
+		Description:  "Describes a parameter group.",
+		Resolver:     fetchRedshiftParameterGroups,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("redshift"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "arn",
+				Description: "ARN of the parameter group.",
+				Type:        schema.TypeString,
+				Resolver:    resolveParameterGroupARN,
+			},
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "name",
+				Description: "The name of the cluster parameter group.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ParameterGroupName"),
+			},
+			{
+				Name:        "family",
+				Description: "The name of the cluster parameter group family that this cluster parameter group is compatible with.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("ParameterGroupFamily"),
+			},
+			{
+				Name:        "description",
+				Description: "The description of the parameter group.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "tags",
+				Description: "Tags",
+				Type:        schema.TypeJSON,
+				Resolver:    client.ResolveTags,
+			},
+		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_redshift_parameter_group_parameters",
+				Description: "Describes a parameter in a cluster parameter group.",
+				Resolver:    fetchRedshiftParameterGroupParameters,
+				Columns: []schema.Column{
+					{
+						Name:        "parameter_group_cq_id",
+						Description: "Unique CloudQuery ID of aws_redshift_parameter_groups table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "allowed_values",
+						Description: "The valid range of values for the parameter.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "apply_type",
+						Description: "Specifies how to apply the WLM configuration parameter",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "data_type",
+						Description: "The data type of the parameter.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "description",
+						Description: "A description of the parameter.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "is_modifiable",
+						Description: "If true, the parameter can be modified",
+						Type:        schema.TypeBool,
+					},
+					{
+						Name:          "minimum_engine_version",
+						Description:   "The earliest engine version to which the parameter can apply.",
+						Type:          schema.TypeString,
+						IgnoreInTests: true,
+					},
+					{
+						Name:        "parameter_name",
+						Description: "The name of the parameter.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "parameter_value",
+						Description: "The value of the parameter",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "source",
+						Description: "The source of the parameter value, such as \"engine-default\" or \"user\".",
+						Type:        schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchRedshiftParameterGroups(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	cl := meta.(*client.Client)
+	svc := cl.Services().Redshift
+	var params redshift.DescribeClusterParameterGroupsInput
+	params.MaxRecords = aws.Int32(100)
+	for {
+		result, err := svc.DescribeClusterParameterGroups(ctx, &params)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- result.ParameterGroups
+		if aws.ToString(result.Marker) == "" {
+			break
+		}
+		params.Marker = result.Marker
+	}
+	return nil
+}
+
+func resolveParameterGroupARN(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	group := resource.Item.(types.ClusterParameterGroup)
+	return diag.WrapError(resource.Set(c.Name, cl.ARN(client.RedshiftService, fmt.Sprintf("parametergroup:%s", aws.ToString(group.ParameterGroupName)))))
+}
+
+func fetchRedshiftParameterGroupParameters(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	group := parent.Item.(types.ClusterParameterGroup)
+	config := redshift.DescribeClusterParametersInput{
+		ParameterGroupName: group.ParameterGroupName,
+	}
+	c := meta.(*client.Client)
+	svc := c.Services().Redshift
+	for {
+		response, err := svc.DescribeClusterParameters(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.Parameters
+		if aws.ToString(response.Marker) == "" {
+			break
+		}
+		config.Marker = response.Marker
+	}
+	return nil
+}
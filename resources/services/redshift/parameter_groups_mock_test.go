@@ -0,0 +1,55 @@
+package redshift
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshift"
+	"github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildRedshiftParameterGroupsMock(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockRedshiftClient(ctrl)
+
+	var g types.ClusterParameterGroup
+	if err := faker.FakeData(&g); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeClusterParameterGroups(
+		gomock.Any(),
+		&redshift.DescribeClusterParameterGroupsInput{MaxRecords: aws.Int32(100)},
+		gomock.Any(),
+	).Return(
+		&redshift.DescribeClusterParameterGroupsOutput{
+			ParameterGroups: []types.ClusterParameterGroup{g},
+		},
+		nil,
+	)
+
+	var p types.Parameter
+	if err := faker.FakeData(&p); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().DescribeClusterParameters(
+		gomock.Any(),
+		&redshift.DescribeClusterParametersInput{ParameterGroupName: g.ParameterGroupName},
+		gomock.Any(),
+	).Return(
+		&redshift.DescribeClusterParametersOutput{
+			Parameters: []types.Parameter{p},
+		},
+		nil,
+	)
+
+	return client.Services{
+		Redshift: m,
+	}
+}
+
+func TestRedshiftParameterGroups(t *testing.T) {
+	client.AwsMockTestHelper(t, ParameterGroups(), buildRedshiftParameterGroupsMock, client.TestOptions{})
+}
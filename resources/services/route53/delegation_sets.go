@@ -15,7 +15,7 @@ func Route53ReusableDelegationSets() *schema.Table {
 	return &schema.Table{
 		Name:          "aws_route53_reusable_delegation_sets",
 		Resolver:      fetchRoute53DelegationSets,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("route53"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
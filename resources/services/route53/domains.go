@@ -17,7 +17,7 @@ func Route53Domains() *schema.Table {
 		Name:          "aws_route53_domains",
 		Description:   "The domain names registered with Amazon Route 53.",
 		Resolver:      fetchRoute53Domains,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("route53domains"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "domain_name"}},
@@ -351,6 +351,12 @@ func Route53Domains() *schema.Table {
 				Description: "An array of domain name status codes, also known as Extensible Provisioning Protocol (EPP) status codes",
 				Type:        schema.TypeStringArray,
 			},
+			{
+				Name:        "transfer_lock",
+				Description: "Indicates whether a domain is locked from unauthorized transfer to another party, based on the clientTransferProhibited status code.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveRoute53DomainTransferLock,
+			},
 			{
 				Name:        "tech_privacy",
 				Description: "Specifies whether contact information is concealed from WHOIS queries",
@@ -454,6 +460,16 @@ func resolveRoute53DomainTags(ctx context.Context, meta schema.ClientMeta, resou
 	return diag.WrapError(resource.Set(col.Name, client.TagsToMap(out.TagList)))
 }
 
+func resolveRoute53DomainTransferLock(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, col schema.Column) error {
+	d := resource.Item.(*route53domains.GetDomainDetailOutput)
+	for _, status := range d.StatusList {
+		if status == "clientTransferProhibited" {
+			return diag.WrapError(resource.Set(col.Name, true))
+		}
+	}
+	return diag.WrapError(resource.Set(col.Name, false))
+}
+
 func resolveRoute53DomainContactExtraParams(extractValue func(*route53domains.GetDomainDetailOutput) *types.ContactDetail) func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, col schema.Column) error {
 	return func(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, col schema.Column) error {
 		d := resource.Item.(*route53domains.GetDomainDetailOutput)
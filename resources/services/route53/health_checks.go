@@ -21,7 +21,7 @@ func Route53HealthChecks() *schema.Table {
 		Name:          "aws_route53_health_checks",
 		Description:   "A complex type that contains information about one health check that is associated with the current AWS account.",
 		Resolver:      fetchRoute53HealthChecks,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("route53"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
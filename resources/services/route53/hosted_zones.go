@@ -25,7 +25,7 @@ func Route53HostedZones() *schema.Table {
 		Name:         "aws_route53_hosted_zones",
 		Description:  "A complex type that contains general information about the hosted zone.",
 		Resolver:     fetchRoute53HostedZones,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("route53"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
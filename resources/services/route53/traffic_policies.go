@@ -17,7 +17,7 @@ func Route53TrafficPolicies() *schema.Table {
 		Name:          "aws_route53_traffic_policies",
 		Description:   "A complex type that contains information about the latest version of one traffic policy that is associated with the current AWS account.",
 		Resolver:      fetchRoute53TrafficPolicies,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("route53"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
@@ -109,6 +109,64 @@ func Route53TrafficPolicies() *schema.Table {
 						Type:        schema.TypeString,
 					},
 				},
+				Relations: []*schema.Table{
+					{
+						Name:        "aws_route53_traffic_policy_version_instances",
+						Description: "A complex type that contains settings for the traffic policy instances created with this traffic policy version.",
+						Resolver:    fetchRoute53TrafficPolicyVersionInstances,
+						Columns: []schema.Column{
+							{
+								Name:        "traffic_policy_version_cq_id",
+								Description: "Unique CloudQuery ID of aws_route53_traffic_policy_versions table (FK)",
+								Type:        schema.TypeUUID,
+								Resolver:    schema.ParentIdResolver,
+							},
+							{
+								Name:        "arn",
+								Description: "Amazon Resource Name (ARN) of the route53 traffic policy instance.",
+								Type:        schema.TypeString,
+								Resolver:    resolveRoute53TrafficPolicyVersionInstanceArn,
+							},
+							{
+								Name:        "id",
+								Description: "The ID that Amazon Route 53 assigned to the new traffic policy instance.",
+								Type:        schema.TypeString,
+								Resolver:    schema.PathResolver("Id"),
+							},
+							{
+								Name:        "hosted_zone_id",
+								Description: "The ID of the hosted zone that Amazon Route 53 created resource record sets in.",
+								Type:        schema.TypeString,
+							},
+							{
+								Name:        "message",
+								Description: "If State is Failed, an explanation of the reason for the failure.",
+								Type:        schema.TypeString,
+							},
+							{
+								Name:        "name",
+								Description: "The DNS name, such as www.",
+								Type:        schema.TypeString,
+							},
+							{
+								Name:        "state",
+								Description: "The value of State is one of the following values: Applied, Creating or Failed.",
+								Type:        schema.TypeString,
+							},
+							{
+								Name:        "ttl",
+								Description: "The TTL that Amazon Route 53 assigned to all of the resource record sets that it created in the specified hosted zone.",
+								Type:        schema.TypeBigInt,
+								Resolver:    schema.PathResolver("TTL"),
+							},
+							{
+								Name:        "traffic_policy_type",
+								Description: "The DNS type that Amazon Route 53 assigned to all of the resource record sets that it created for this traffic policy instance.",
+								Type:        schema.TypeString,
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -153,6 +211,30 @@ func fetchRoute53TrafficPolicyVersions(ctx context.Context, meta schema.ClientMe
 	}
 	return nil
 }
+func fetchRoute53TrafficPolicyVersionInstances(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	r := parent.Item.(types.TrafficPolicy)
+	config := route53.ListTrafficPolicyInstancesByPolicyInput{TrafficPolicyId: r.Id, TrafficPolicyVersion: r.Version}
+	svc := meta.(*client.Client).Services().Route53
+	for {
+		response, err := svc.ListTrafficPolicyInstancesByPolicy(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.TrafficPolicyInstances
+		if !response.IsTruncated {
+			break
+		}
+		config.HostedZoneIdMarker = response.HostedZoneIdMarker
+		config.TrafficPolicyInstanceNameMarker = response.TrafficPolicyInstanceNameMarker
+		config.TrafficPolicyInstanceTypeMarker = response.TrafficPolicyInstanceTypeMarker
+	}
+	return nil
+}
+func resolveRoute53TrafficPolicyVersionInstanceArn(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	cl := meta.(*client.Client)
+	tp := resource.Item.(types.TrafficPolicyInstance)
+	return diag.WrapError(resource.Set(c.Name, cl.PartitionGlobalARN(client.Route53Service, "trafficpolicyinstance", *tp.Id)))
+}
 func resolveRoute53trafficPolicyVersionDocument(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(types.TrafficPolicy)
 	var value interface{}
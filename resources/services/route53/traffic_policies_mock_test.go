@@ -32,6 +32,14 @@ func buildRoute53TrafficPoliciesMock(t *testing.T, ctrl *gomock.Controller) clie
 		&route53.ListTrafficPolicyVersionsOutput{
 			TrafficPolicies: []route53Types.TrafficPolicy{tp},
 		}, nil)
+	tpi := route53Types.TrafficPolicyInstance{}
+	if err := faker.FakeData(&tpi); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListTrafficPolicyInstancesByPolicy(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&route53.ListTrafficPolicyInstancesByPolicyOutput{
+			TrafficPolicyInstances: []route53Types.TrafficPolicyInstance{tpi},
+		}, nil)
 	return client.Services{
 		Route53: m,
 	}
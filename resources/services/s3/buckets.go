@@ -635,7 +635,7 @@ func fetchS3BucketEncryptionRules(ctx context.Context, meta schema.ClientMeta, p
 		options.Region = parent.Get("region").(string)
 	})
 	if err != nil {
-		if client.IsAWSError(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		if client.IsAWSError(err, "ServerSideEncryptionConfigurationNotFoundError", "NoSuchBucket") {
 			return nil
 		}
 		return diag.WrapError(err)
@@ -655,7 +655,7 @@ func fetchS3BucketLifecycles(ctx context.Context, meta schema.ClientMeta, parent
 		options.Region = parent.Get("region").(string)
 	})
 	if err != nil {
-		if client.IsAWSError(err, "NoSuchLifecycleConfiguration") {
+		if client.IsAWSError(err, "NoSuchLifecycleConfiguration", "NoSuchBucket") {
 			return nil
 		}
 		return diag.WrapError(err)
@@ -720,6 +720,9 @@ func resolveBucketVersioning(ctx context.Context, meta schema.ClientMeta, resour
 		options.Region = bucketRegion
 	})
 	if err != nil {
+		if isBucketNotFoundError(c, err) {
+			return nil
+		}
 		if client.IgnoreAccessDeniedServiceDisabled(err) {
 			meta.Logger().Warn("received access denied on GetBucketVersioning", "bucket", resource.Name, "err", err)
 			return nil
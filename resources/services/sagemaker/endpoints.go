@@ -0,0 +1,150 @@
+package sagemaker
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func SagemakerEndpoints() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_sagemaker_endpoints",
+		Description:  "Describes an Amazon SageMaker endpoint.",
+		Resolver:     fetchSagemakerEndpoints,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("api.sagemaker"),
+		IgnoreError:  client.IgnoreAccessDeniedServiceDisabled,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the endpoint.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("EndpointArn"),
+			},
+			{
+				Name:        "name",
+				Description: "Name of the endpoint.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("EndpointName"),
+			},
+			{
+				Name:        "status",
+				Description: "The status of the endpoint.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("EndpointStatus"),
+			},
+			{
+				Name:        "endpoint_config_name",
+				Description: "The name of the endpoint configuration associated with this endpoint.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "creation_time",
+				Description: "A timestamp that shows when the endpoint was created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "last_modified_time",
+				Description: "A timestamp that shows when the endpoint was last modified.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "failure_reason",
+				Description: "If the status of the endpoint is Failed, the reason why it failed.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "production_variants",
+				Description: "An array of the production variants hosted on the endpoint.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("ProductionVariants"),
+			},
+			{
+				Name:        "data_capture_config",
+				Description: "The current data capture configuration of the endpoint.",
+				Type:        schema.TypeJSON,
+				Resolver:    schema.PathResolver("DataCaptureConfig"),
+			},
+			{
+				Name:        "tags",
+				Description: "The tags associated with the endpoint.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveSagemakerEndpointTags,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchSagemakerEndpoints(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	c := meta.(*client.Client)
+	svc := c.Services().SageMaker
+	config := sagemaker.ListEndpointsInput{}
+	for {
+		response, err := svc.ListEndpoints(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+
+		// get more details about the endpoint
+		for _, n := range response.Endpoints {
+			config := sagemaker.DescribeEndpointInput{
+				EndpointName: n.EndpointName,
+			}
+			response, err := svc.DescribeEndpoint(ctx, &config, func(options *sagemaker.Options) {
+				options.Region = c.Region
+			})
+			if err != nil {
+				return diag.WrapError(err)
+			}
+
+			res <- response
+		}
+
+		if aws.ToString(response.NextToken) == "" {
+			break
+		}
+		config.NextToken = response.NextToken
+	}
+	return nil
+}
+
+func resolveSagemakerEndpointTags(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, _ schema.Column) error {
+	r := resource.Item.(*sagemaker.DescribeEndpointOutput)
+	c := meta.(*client.Client)
+	svc := c.Services().SageMaker
+	config := sagemaker.ListTagsInput{
+		ResourceArn: r.EndpointArn,
+	}
+	response, err := svc.ListTags(ctx, &config)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+
+	tags := make(map[string]*string, len(response.Tags))
+	for _, t := range response.Tags {
+		tags[*t.Key] = t.Value
+	}
+
+	return diag.WrapError(resource.Set("tags", tags))
+}
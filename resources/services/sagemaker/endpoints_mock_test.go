@@ -0,0 +1,52 @@
+package sagemaker
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	types "github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildSageMakerEndpoints(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockSageMakerClient(ctrl)
+
+	summ := types.EndpointSummary{}
+	if err := faker.FakeData(&summ); err != nil {
+		t.Fatal(err)
+	}
+
+	m.EXPECT().ListEndpoints(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&sagemaker.ListEndpointsOutput{Endpoints: []types.EndpointSummary{summ}},
+		nil,
+	)
+
+	endpoint := sagemaker.DescribeEndpointOutput{}
+	if err := faker.FakeData(&endpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	m.EXPECT().DescribeEndpoint(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&endpoint,
+		nil,
+	)
+
+	var tagsOut sagemaker.ListTagsOutput
+	if err := faker.FakeData(&tagsOut); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListTags(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&tagsOut, nil,
+	)
+
+	return client.Services{
+		SageMaker: m,
+	}
+}
+
+func TestSageMakerEndpoints(t *testing.T) {
+	client.AwsMockTestHelper(t, SagemakerEndpoints(), buildSageMakerEndpoints, client.TestOptions{})
+}
@@ -141,6 +141,17 @@ func SagemakerNotebookInstances() *schema.Table {
 				Description: "The URL that you use to connect to the Jupyter instance running in your notebook instance.",
 				Type:        schema.TypeString,
 			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role associated with the instance.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "root_access",
+				Description: "Whether root access is enabled or disabled for users of the notebook instance.",
+				Type:        schema.TypeBool,
+				Resolver:    resolveSagemakerNotebookInstanceRootAccess,
+			},
 		},
 	}
 }
@@ -216,3 +227,8 @@ func resolveSagemakerNotebookInstanceDirectInternetAccess(_ context.Context, _ s
 
 	return diag.WrapError(resource.Set("direct_internet_access", false))
 }
+
+func resolveSagemakerNotebookInstanceRootAccess(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	r := resource.Item.(WrappedSageMakerNotebookInstance)
+	return diag.WrapError(resource.Set(c.Name, r.RootAccess == sagemakertypes.RootAccessEnabled))
+}
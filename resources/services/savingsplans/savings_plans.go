@@ -0,0 +1,147 @@
+package savingsplans
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func SavingsPlans() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_savingsplans_savings_plans",
+		Description:  "Information about a Savings Plan.",
+		Resolver:     fetchSavingsplansSavingsPlans,
+		Multiplex:    client.ServiceAccountMultiplexer("savingsplans"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) of the Savings Plan.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("SavingsPlanArn"),
+			},
+			{
+				Name:        "id",
+				Description: "The ID of the Savings Plan.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("SavingsPlanId"),
+			},
+			{
+				Name:        "type",
+				Description: "The plan type. One of Compute, EC2Instance or SageMaker.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("SavingsPlanType"),
+			},
+			{
+				Name:        "state",
+				Description: "The current state of the Savings Plan, such as payment-pending, active, retired or queued.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "description",
+				Description: "The description of the Savings Plan.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region the Savings Plan applies to, if it is limited to one.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "commitment",
+				Description: "The hourly commitment, in USD.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "currency",
+				Description: "The currency of the commitment and payment amounts.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "ec2_instance_family",
+				Description: "The EC2 instance family covered by an EC2 Instance Savings Plan.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "offering_id",
+				Description: "The ID of the Savings Plan offering.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "payment_option",
+				Description: "The payment option. One of All Upfront, Partial Upfront or No Upfront.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "product_types",
+				Description: "The product types the Savings Plan applies to, such as EC2, Fargate, Lambda or SageMaker.",
+				Type:        schema.TypeStringArray,
+			},
+			{
+				Name:        "recurring_payment_amount",
+				Description: "The hourly recurring payment amount.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "upfront_payment_amount",
+				Description: "The up-front payment amount.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "start",
+				Description: "The time the Savings Plan term starts.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.DateResolver("Start"),
+			},
+			{
+				Name:        "end",
+				Description: "The time the Savings Plan term ends.",
+				Type:        schema.TypeTimestamp,
+				Resolver:    schema.DateResolver("End"),
+			},
+			{
+				Name:        "term_duration_in_seconds",
+				Description: "The duration of the term, in seconds.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "tags",
+				Description: "The tags assigned to the Savings Plan.",
+				Type:        schema.TypeJSON,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchSavingsplansSavingsPlans(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().SavingsPlans
+	input := savingsplans.DescribeSavingsPlansInput{MaxResults: aws.Int32(1000)}
+	for {
+		output, err := svc.DescribeSavingsPlans(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.SavingsPlans
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package savingsplans
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
+	"github.com/aws/aws-sdk-go-v2/service/savingsplans/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildSavingsplansSavingsPlans(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockSavingsPlansClient(ctrl)
+
+	var plan types.SavingsPlan
+	if err := faker.FakeData(&plan); err != nil {
+		t.Fatal(err)
+	}
+	plan.Start = aws.String("2022-01-01T00:00:00.000Z")
+	plan.End = aws.String("2025-01-01T00:00:00.000Z")
+	m.EXPECT().DescribeSavingsPlans(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&savingsplans.DescribeSavingsPlansOutput{SavingsPlans: []types.SavingsPlan{plan}},
+		nil,
+	)
+
+	return client.Services{SavingsPlans: m}
+}
+
+func TestSavingsplansSavingsPlans(t *testing.T) {
+	client.AwsMockTestHelper(t, SavingsPlans(), buildSavingsplansSavingsPlans, client.TestOptions{})
+}
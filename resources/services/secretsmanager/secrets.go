@@ -138,6 +138,41 @@ func SecretsmanagerSecrets() *schema.Table {
 				Resolver:    client.ResolveTags,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_secretsmanager_secret_versions",
+				Description: "A structure that contains information about one version of a secret",
+				Resolver:    fetchSecretsmanagerSecretVersions,
+				Columns: []schema.Column{
+					{
+						Name:        "secret_cq_id",
+						Description: "Unique CloudQuery ID of aws_secretsmanager_secrets table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "version_id",
+						Description: "The unique version identifier of this version of the secret",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "version_stages",
+						Description: "An array of staging labels that are currently associated with this version of the secret",
+						Type:        schema.TypeStringArray,
+					},
+					{
+						Name:        "created_date",
+						Description: "The date and time this version of the secret was created",
+						Type:        schema.TypeTimestamp,
+					},
+					{
+						Name:        "last_accessed_date",
+						Description: "The date that this version of the secret was last accessed",
+						Type:        schema.TypeTimestamp,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -186,6 +221,28 @@ func fetchSecretsmanagerSecrets(ctx context.Context, meta schema.ClientMeta, _ *
 	return nil
 }
 
+func fetchSecretsmanagerSecretVersions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	secret := parent.Item.(WrappedSecret)
+	svc := meta.(*client.Client).Services().SecretsManager
+	// versions without staging labels are deprecated, they are listed too so they can be audited
+	cfg := secretsmanager.ListSecretVersionIdsInput{
+		SecretId:          secret.ARN,
+		IncludeDeprecated: true,
+	}
+	for {
+		response, err := svc.ListSecretVersionIds(ctx, &cfg)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- response.Versions
+		if aws.ToString(response.NextToken) == "" {
+			break
+		}
+		cfg.NextToken = response.NextToken
+	}
+	return nil
+}
+
 func fetchSecretsmanagerSecretPolicy(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	r := resource.Item.(WrappedSecret)
 	cl := meta.(*client.Client)
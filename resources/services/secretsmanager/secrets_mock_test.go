@@ -43,6 +43,16 @@ func buildSecretsmanagerModels(t *testing.T, ctrl *gomock.Controller) client.Ser
 		nil,
 	)
 
+	var versions secretsmanager.ListSecretVersionIdsOutput
+	if err := faker.FakeData(&versions); err != nil {
+		t.Fatal(err)
+	}
+	versions.NextToken = nil
+	m.EXPECT().ListSecretVersionIds(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&versions,
+		nil,
+	)
+
 	return client.Services{
 		SecretsManager: m,
 	}
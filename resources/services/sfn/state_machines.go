@@ -0,0 +1,153 @@
+package sfn
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func StateMachines() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_sfn_state_machines",
+		Description:  "Describes a Step Functions state machine.",
+		Resolver:     fetchSfnStateMachines,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("states"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "arn",
+				Description: "The Amazon Resource Name (ARN) that identifies the state machine.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("StateMachineArn"),
+			},
+			{
+				Name:        "name",
+				Description: "The name of the state machine.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "status",
+				Description: "The current status of the state machine.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "The type of the state machine (STANDARD or EXPRESS).",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "role_arn",
+				Description: "The Amazon Resource Name (ARN) of the IAM role used when creating this state machine.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "definition",
+				Description: "The Amazon States Language definition of the state machine.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveSfnStateMachineDefinition,
+			},
+			{
+				Name:        "creation_date",
+				Description: "The date the state machine is created.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "logging_configuration_level",
+				Description: "Defines which category of execution history events are logged.",
+				Type:        schema.TypeString,
+				Resolver:    schema.PathResolver("LoggingConfiguration.Level"),
+			},
+			{
+				Name:        "logging_configuration_include_execution_data",
+				Description: "Determines whether execution data is included in your log.",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("LoggingConfiguration.IncludeExecutionData"),
+			},
+			{
+				Name:        "logging_configuration_destinations",
+				Description: "An array of objects that describes where your execution history events will be logged.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveSfnStateMachineLoggingDestinations,
+			},
+			{
+				Name:        "tracing_configuration_enabled",
+				Description: "When set to true, X-Ray tracing is enabled.",
+				Type:        schema.TypeBool,
+				Resolver:    schema.PathResolver("TracingConfiguration.Enabled"),
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+func fetchSfnStateMachines(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().SFN
+	input := sfn.ListStateMachinesInput{}
+	for {
+		output, err := svc.ListStateMachines(ctx, &input)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		for _, sm := range output.StateMachines {
+			details, err := svc.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{StateMachineArn: sm.StateMachineArn})
+			if err != nil {
+				if client.IsAWSError(err, "StateMachineDoesNotExist") {
+					continue
+				}
+				return diag.WrapError(err)
+			}
+			res <- details
+		}
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveSfnStateMachineDefinition(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	sm := resource.Item.(*sfn.DescribeStateMachineOutput)
+	if sm.Definition == nil {
+		return nil
+	}
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(*sm.Definition), &definition); err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, definition))
+}
+
+func resolveSfnStateMachineLoggingDestinations(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	sm := resource.Item.(*sfn.DescribeStateMachineOutput)
+	if sm.LoggingConfiguration == nil {
+		return nil
+	}
+	b, err := json.Marshal(sm.LoggingConfiguration.Destinations)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
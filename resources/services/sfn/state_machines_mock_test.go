@@ -0,0 +1,39 @@
+package sfn
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildSfnStateMachines(t *testing.T, ctrl *gomock.Controller) client.Services {
+	m := mocks.NewMockSFNClient(ctrl)
+
+	var item types.StateMachineListItem
+	if err := faker.FakeData(&item); err != nil {
+		t.Fatal(err)
+	}
+	m.EXPECT().ListStateMachines(gomock.Any(), &sfn.ListStateMachinesInput{}, gomock.Any()).Return(
+		&sfn.ListStateMachinesOutput{StateMachines: []types.StateMachineListItem{item}},
+		nil,
+	)
+
+	var sm sfn.DescribeStateMachineOutput
+	if err := faker.FakeData(&sm); err != nil {
+		t.Fatal(err)
+	}
+	sm.Definition = aws.String(`{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`)
+	m.EXPECT().DescribeStateMachine(gomock.Any(), &sfn.DescribeStateMachineInput{StateMachineArn: item.StateMachineArn}, gomock.Any()).Return(&sm, nil)
+
+	return client.Services{SFN: m}
+}
+
+func TestSfnStateMachines(t *testing.T) {
+	client.AwsMockTestHelper(t, StateMachines(), buildSfnStateMachines, client.TestOptions{})
+}
@@ -18,7 +18,7 @@ func Attacks() *schema.Table {
 		Name:          "aws_shield_attacks",
 		Description:   "The details of a DDoS attack",
 		Resolver:      fetchShieldAttacks,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("shield"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"id"}},
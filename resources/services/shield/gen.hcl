@@ -12,7 +12,8 @@ resource "aws" "shield" "protections" {
     path = "github.com/cloudquery/cq-provider-aws/client.IgnoreAccessDeniedServiceDisabled"
   }
   multiplex "AwsAccount" {
-    path = "github.com/cloudquery/cq-provider-aws/client.AccountMultiplex"
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["shield"]
   }
   deleteFilter "AccountRegionFilter" {
     path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountFilter"
@@ -58,7 +59,8 @@ resource "aws" "shield" "subscriptions" {
     path = "github.com/cloudquery/cq-provider-aws/client.IgnoreAccessDeniedServiceDisabled"
   }
   multiplex "AwsAccount" {
-    path = "github.com/cloudquery/cq-provider-aws/client.AccountMultiplex"
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["shield"]
   }
   deleteFilter "AccountRegionFilter" {
     path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountFilter"
@@ -120,7 +122,8 @@ resource "aws" "shield" "attacks" {
     path = "github.com/cloudquery/cq-provider-aws/client.IgnoreAccessDeniedServiceDisabled"
   }
   multiplex "AwsAccount" {
-    path = "github.com/cloudquery/cq-provider-aws/client.AccountMultiplex"
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["shield"]
   }
   deleteFilter "AccountRegionFilter" {
     path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountFilter"
@@ -178,7 +181,8 @@ resource "aws" "shield" "protection_groups" {
     path = "github.com/cloudquery/cq-provider-aws/client.IgnoreAccessDeniedServiceDisabled"
   }
   multiplex "AwsAccount" {
-    path = "github.com/cloudquery/cq-provider-aws/client.AccountMultiplex"
+    path   = "github.com/cloudquery/cq-provider-aws/client.ServiceAccountMultiplexer"
+    params = ["shield"]
   }
   deleteFilter "AccountRegionFilter" {
     path = "github.com/cloudquery/cq-provider-aws/client.DeleteAccountFilter"
@@ -17,7 +17,7 @@ func ProtectionGroups() *schema.Table {
 		Name:          "aws_shield_protection_groups",
 		Description:   "A grouping of protected resources that you and Shield Advanced can monitor as a collective",
 		Resolver:      fetchShieldProtectionGroups,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("shield"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
@@ -17,7 +17,7 @@ func Protections() *schema.Table {
 		Name:          "aws_shield_protections",
 		Description:   "An object that represents a resource that is under DDoS protection.",
 		Resolver:      fetchShieldProtections,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("shield"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
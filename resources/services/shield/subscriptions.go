@@ -16,7 +16,7 @@ func Subscriptions() *schema.Table {
 		Name:          "aws_shield_subscriptions",
 		Description:   "Information about the Shield Advanced subscription for an account",
 		Resolver:      fetchShieldSubscriptions,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("shield"),
 		IgnoreError:   client.IgnoreAccessDeniedServiceDisabled,
 		DeleteFilter:  client.DeleteAccountFilter,
 		Options:       schema.TableCreationOptions{PrimaryKeys: []string{"arn"}},
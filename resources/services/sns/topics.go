@@ -104,6 +104,53 @@ func SnsTopics() *schema.Table {
 				Resolver:    resolveTopicTags,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:                 "aws_sns_topic_subscriptions",
+				Description:          "A subscription of an AWS SNS topic",
+				Resolver:             fetchSnsTopicSubscriptions,
+				PostResourceResolver: resolveTopicSubscriptionAttributes,
+				Columns: []schema.Column{
+					{
+						Name:        "topic_cq_id",
+						Description: "Unique CloudQuery ID of aws_sns_topics table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "arn",
+						Description: "The subscription's ARN, null while the subscription is pending confirmation.",
+						Type:        schema.TypeString,
+						Resolver:    resolveTopicSubscriptionArn,
+					},
+					{
+						Name:        "protocol",
+						Description: "The subscription's protocol.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "endpoint",
+						Description: "The subscription's endpoint (format depends on the protocol).",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "owner",
+						Description: "The subscription's owner.",
+						Type:        schema.TypeString,
+					},
+					{
+						Name:        "raw_message_delivery",
+						Description: "True if raw message delivery is enabled for the subscription.",
+						Type:        schema.TypeBool,
+					},
+					{
+						Name:        "filter_policy",
+						Description: "The filter policy JSON assigned to the subscription.",
+						Type:        schema.TypeJSON,
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -199,3 +246,64 @@ func resolveTopicTags(ctx context.Context, meta schema.ClientMeta, resource *sch
 	}
 	return diag.WrapError(resource.Set(col.Name, client.TagsToMap(tags.Tags)))
 }
+
+func fetchSnsTopicSubscriptions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	topic := parent.Item.(types.Topic)
+	svc := meta.(*client.Client).Services().SNS
+	config := sns.ListSubscriptionsByTopicInput{TopicArn: topic.TopicArn}
+	for {
+		output, err := svc.ListSubscriptionsByTopic(ctx, &config)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.Subscriptions
+
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		config.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveTopicSubscriptionArn(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	subscription := resource.Item.(types.Subscription)
+	if isPendingSubscription(subscription) {
+		return nil
+	}
+	return diag.WrapError(resource.Set(c.Name, subscription.SubscriptionArn))
+}
+
+func resolveTopicSubscriptionAttributes(ctx context.Context, meta schema.ClientMeta, resource *schema.Resource) error {
+	subscription := resource.Item.(types.Subscription)
+	// attributes of a subscription can't be read before it is confirmed
+	if isPendingSubscription(subscription) {
+		return nil
+	}
+	svc := meta.(*client.Client).Services().SNS
+	output, err := svc.GetSubscriptionAttributes(ctx, &sns.GetSubscriptionAttributesInput{SubscriptionArn: subscription.SubscriptionArn})
+	if err != nil {
+		if client.IgnoreResourceNotFound(err) {
+			return nil
+		}
+		return diag.WrapError(err)
+	}
+	if err := resource.Set("raw_message_delivery", cast.ToBool(output.Attributes["RawMessageDelivery"])); err != nil {
+		return diag.WrapError(err)
+	}
+	if p, ok := output.Attributes["FilterPolicy"]; ok && p != "" {
+		if err := resource.Set("filter_policy", p); err != nil {
+			return diag.WrapError(err)
+		}
+	}
+	return nil
+}
+
+// ====================================================================================================================
+//                                                  User Defined Helpers
+// ====================================================================================================================
+
+// isPendingSubscription reports whether SNS returned the "PendingConfirmation" placeholder instead of an ARN
+func isPendingSubscription(subscription types.Subscription) bool {
+	return aws.ToString(subscription.SubscriptionArn) == "PendingConfirmation"
+}
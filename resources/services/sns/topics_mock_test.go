@@ -3,6 +3,7 @@ package sns
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	"github.com/aws/aws-sdk-go-v2/service/sns/types"
 	"github.com/cloudquery/cq-provider-aws/client"
@@ -48,6 +49,23 @@ func buildSnsTopics(t *testing.T, ctrl *gomock.Controller) client.Services {
 		&sns.ListTagsForResourceOutput{
 			Tags: []types.Tag{tag},
 		}, nil)
+	subscription := types.Subscription{}
+	if err := faker.FakeData(&subscription); err != nil {
+		t.Fatal(err)
+	}
+	pending := subscription
+	pending.SubscriptionArn = aws.String("PendingConfirmation")
+	m.EXPECT().ListSubscriptionsByTopic(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&sns.ListSubscriptionsByTopicOutput{
+			Subscriptions: []types.Subscription{subscription, pending},
+		}, nil)
+	m.EXPECT().GetSubscriptionAttributes(gomock.Any(), &sns.GetSubscriptionAttributesInput{SubscriptionArn: subscription.SubscriptionArn}, gomock.Any()).Return(
+		&sns.GetSubscriptionAttributesOutput{
+			Attributes: map[string]string{
+				"RawMessageDelivery": "true",
+				"FilterPolicy":       `{"event": ["created"]}`,
+			},
+		}, nil)
 	return client.Services{
 		SNS: m,
 	}
@@ -2,6 +2,7 @@ package sqs
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -10,6 +11,7 @@ import (
 	"github.com/cloudquery/cq-provider-sdk/provider/diag"
 	"github.com/cloudquery/cq-provider-sdk/provider/schema"
 	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/cast"
 )
 
 type sqsQueue struct {
@@ -38,6 +40,15 @@ type sqsQueue struct {
 	UnknownFields map[string]interface{} `mapstructure:",remain"`
 
 	Tags map[string]string
+
+	DeadLetterTargetArn *string `mapstructure:"-"`
+	MaxReceiveCount     *int64  `mapstructure:"-"`
+}
+
+// redrivePolicy is the RedrivePolicy queue attribute, older queues return maxReceiveCount as a string
+type redrivePolicy struct {
+	DeadLetterTargetArn string      `json:"deadLetterTargetArn"`
+	MaxReceiveCount     interface{} `json:"maxReceiveCount"`
 }
 
 func SQSQueues() *schema.Table {
@@ -138,6 +149,16 @@ func SQSQueues() *schema.Table {
 				Type:          schema.TypeJSON,
 				IgnoreInTests: true,
 			},
+			{
+				Name:        "dead_letter_target_arn",
+				Description: "The Amazon Resource Name (ARN) of the dead-letter queue to which Amazon SQS moves messages after the value of max_receive_count is exceeded.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "max_receive_count",
+				Description: "The number of times a message is delivered to the source queue before being moved to the dead-letter queue.",
+				Type:        schema.TypeBigInt,
+			},
 			{
 				Name:          "fifo_queue",
 				Description:   "True if the queue is FIFO queue.",
@@ -233,6 +254,10 @@ func fetchSQSQueues(ctx context.Context, meta schema.ClientMeta, parent *schema.
 				return diag.WrapError(err)
 			}
 			q.URL = url
+			if err := q.parseRedrivePolicy(); err != nil {
+				// keep the queue, only the dead-letter target columns are left empty
+				meta.Logger().Warn("failed to parse queue redrive policy", "url", url, "err", err)
+			}
 
 			tagsOut, err := sqsClient.ListQueueTags(ctx, &sqs.ListQueueTagsInput{QueueUrl: &url}, optsFn)
 			if err != nil {
@@ -248,3 +273,28 @@ func fetchSQSQueues(ctx context.Context, meta schema.ClientMeta, parent *schema.
 	}
 	return nil
 }
+
+// parseRedrivePolicy fills the dead-letter target columns from the RedrivePolicy attribute, if the queue has one.
+// The columns are left unset if the policy can't be parsed.
+func (q *sqsQueue) parseRedrivePolicy() error {
+	if aws.ToString(q.RedrivePolicy) == "" {
+		return nil
+	}
+	var p redrivePolicy
+	if err := json.Unmarshal([]byte(*q.RedrivePolicy), &p); err != nil {
+		return err
+	}
+	var maxReceiveCount *int64
+	if p.MaxReceiveCount != nil {
+		count, err := cast.ToInt64E(p.MaxReceiveCount)
+		if err != nil {
+			return err
+		}
+		maxReceiveCount = aws.Int64(count)
+	}
+	if p.DeadLetterTargetArn != "" {
+		q.DeadLetterTargetArn = aws.String(p.DeadLetterTargetArn)
+	}
+	q.MaxReceiveCount = maxReceiveCount
+	return nil
+}
@@ -3,11 +3,13 @@ package sqs
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	"github.com/cloudquery/cq-provider-aws/client"
 	"github.com/cloudquery/cq-provider-aws/client/mocks"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 func buildSQSQueues(t *testing.T, ctrl *gomock.Controller) client.Services {
@@ -40,7 +42,7 @@ func buildSQSQueues(t *testing.T, ctrl *gomock.Controller) client.Services {
 				"ApproximateNumberOfMessagesDelayed":    "6",
 				"DelaySeconds":                          "7",
 				"ReceiveMessageWaitTimeSeconds":         "8",
-				"RedrivePolicy":                         `{"field2":2}`,
+				"RedrivePolicy":                         `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:704956590351:terraform-example-dlq","maxReceiveCount":"4"}`,
 				"FifoQueue":                             "true",
 				"ContentBasedDeduplication":             "false",
 				"KmsMasterKeyId":                        "key",
@@ -65,3 +67,25 @@ func buildSQSQueues(t *testing.T, ctrl *gomock.Controller) client.Services {
 func TestSQSQueues(t *testing.T) {
 	client.AwsMockTestHelper(t, SQSQueues(), buildSQSQueues, client.TestOptions{})
 }
+
+func TestParseRedrivePolicy(t *testing.T) {
+	cases := []struct {
+		policy  string
+		arn     *string
+		count   *int64
+		wantErr bool
+	}{
+		{`{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:dlq","maxReceiveCount":"4"}`, aws.String("arn:aws:sqs:us-east-1:123456789012:dlq"), aws.Int64(4), false},
+		{`{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:dlq","maxReceiveCount":5}`, aws.String("arn:aws:sqs:us-east-1:123456789012:dlq"), aws.Int64(5), false},
+		{`{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:dlq","maxReceiveCount":"many"}`, nil, nil, true},
+		{`not json`, nil, nil, true},
+		{``, nil, nil, false},
+	}
+	for _, tc := range cases {
+		q := sqsQueue{RedrivePolicy: aws.String(tc.policy)}
+		err := q.parseRedrivePolicy()
+		assert.Equal(t, tc.wantErr, err != nil, tc.policy)
+		assert.Equal(t, tc.arn, q.DeadLetterTargetArn, tc.policy)
+		assert.Equal(t, tc.count, q.MaxReceiveCount, tc.policy)
+	}
+}
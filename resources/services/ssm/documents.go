@@ -3,6 +3,7 @@ package ssm
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
@@ -210,9 +211,40 @@ func SsmDocuments() *schema.Table {
 				IgnoreInTests: true,
 			},
 		},
+		Relations: []*schema.Table{
+			{
+				Name:        "aws_ssm_document_permissions",
+				Description: "An account the SSM document is shared with.",
+				Resolver:    fetchSsmDocumentPermissions,
+				Columns: []schema.Column{
+					{
+						Name:        "document_cq_id",
+						Description: "Unique CloudQuery ID of aws_ssm_documents table (FK)",
+						Type:        schema.TypeUUID,
+						Resolver:    schema.ParentIdResolver,
+					},
+					{
+						Name:        "account_id",
+						Description: "The account ID that has permission to use the document, \"all\" if the document is public.",
+						Type:        schema.TypeString,
+						Resolver:    schema.PathResolver("AccountID"),
+					},
+					{
+						Name:        "public",
+						Description: "True if the document is shared publicly with all accounts.",
+						Type:        schema.TypeBool,
+					},
+				},
+			},
+		},
 	}
 }
 
+type documentPermission struct {
+	AccountID string
+	Public    bool
+}
+
 // ====================================================================================================================
 //                                               Table Resolver Functions
 // ====================================================================================================================
@@ -288,6 +320,15 @@ func ssmDocumentPostResolver(ctx context.Context, meta schema.ClientMeta, resour
 	return diag.WrapError(resource.Set("account_sharing_info_list", b))
 }
 
+// fetchSsmDocumentPermissions reuses the account IDs the post resolver of aws_ssm_documents got from DescribeDocumentPermission
+func fetchSsmDocumentPermissions(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	accountIDs, _ := parent.Get("account_ids").([]string)
+	for _, id := range accountIDs {
+		res <- documentPermission{AccountID: id, Public: strings.EqualFold(id, "all")}
+	}
+	return nil
+}
+
 func resolveSSMDocumentARN(_ context.Context, meta schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
 	d := resource.Item.(*types.DocumentDescription)
 	cl := meta.(*client.Client)
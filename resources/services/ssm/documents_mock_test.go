@@ -48,7 +48,7 @@ func buildSSMDocuments(t *testing.T, ctrl *gomock.Controller) client.Services {
 		gomock.Any(),
 	).Return(
 		&ssm.DescribeDocumentPermissionOutput{
-			AccountIds:             []string{"some"},
+			AccountIds:             []string{"some", "all"},
 			AccountSharingInfoList: []types.AccountSharingInfo{{AccountId: aws.String("other"), SharedDocumentVersion: aws.String("version")}},
 		},
 		nil,
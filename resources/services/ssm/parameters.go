@@ -0,0 +1,137 @@
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-sdk/provider/diag"
+	"github.com/cloudquery/cq-provider-sdk/provider/schema"
+)
+
+func SsmParameters() *schema.Table {
+	return &schema.Table{
+		Name:         "aws_ssm_parameters",
+		Description:  "Metadata includes information like the ARN of the last user and the date/time the parameter was last used.",
+		Resolver:     fetchSsmParameters,
+		Multiplex:    client.ServiceAccountRegionMultiplexer("ssm"),
+		IgnoreError:  client.IgnoreCommonErrors,
+		DeleteFilter: client.DeleteAccountRegionFilter,
+		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "region", "name"}},
+		Columns: []schema.Column{
+			{
+				Name:        "account_id",
+				Description: "The AWS Account ID of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSAccount,
+			},
+			{
+				Name:        "region",
+				Description: "The AWS Region of the resource.",
+				Type:        schema.TypeString,
+				Resolver:    client.ResolveAWSRegion,
+			},
+			{
+				Name:        "name",
+				Description: "The parameter name.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "type",
+				Description: "The type of parameter. Valid parameter types include the following: String, StringList, and SecureString.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "description",
+				Description: "Description of the parameter actions.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "key_id",
+				Description: "The ID of the query key used for this parameter.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "last_modified_date",
+				Description: "Date the parameter was last changed or updated.",
+				Type:        schema.TypeTimestamp,
+			},
+			{
+				Name:        "last_modified_user",
+				Description: "Amazon Resource Name (ARN) of the Amazon Web Services user who last changed the parameter.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "version",
+				Description: "The parameter version.",
+				Type:        schema.TypeBigInt,
+			},
+			{
+				Name:        "tier",
+				Description: "The parameter tier.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "policies",
+				Description: "A list of policies associated with a parameter.",
+				Type:        schema.TypeJSON,
+				Resolver:    resolveSSMParameterPolicies,
+			},
+			{
+				Name:        "data_type",
+				Description: "The data type of the parameter, such as text or aws:ec2:image.",
+				Type:        schema.TypeString,
+			},
+			{
+				Name:        "allowed_pattern",
+				Description: "A parameter name can include only the following letters and symbols a-zA-Z0-9_.-",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+// ====================================================================================================================
+//                                               Table Resolver Functions
+// ====================================================================================================================
+
+// fetchSsmParameters lists parameter metadata only, parameter values (including SecureString ones) are never fetched
+func fetchSsmParameters(ctx context.Context, meta schema.ClientMeta, parent *schema.Resource, res chan<- interface{}) error {
+	svc := meta.(*client.Client).Services().SSM
+	var params ssm.DescribeParametersInput
+	for {
+		output, err := svc.DescribeParameters(ctx, &params)
+		if err != nil {
+			return diag.WrapError(err)
+		}
+		res <- output.Parameters
+		if aws.ToString(output.NextToken) == "" {
+			break
+		}
+		params.NextToken = output.NextToken
+	}
+	return nil
+}
+
+func resolveSSMParameterPolicies(_ context.Context, _ schema.ClientMeta, resource *schema.Resource, c schema.Column) error {
+	p := resource.Item.(types.ParameterMetadata)
+	if len(p.Policies) == 0 {
+		return nil
+	}
+	policies := make([]map[string]interface{}, len(p.Policies))
+	for i, policy := range p.Policies {
+		policies[i] = map[string]interface{}{
+			"policy_status": aws.ToString(policy.PolicyStatus),
+			"policy_text":   aws.ToString(policy.PolicyText),
+			"policy_type":   aws.ToString(policy.PolicyType),
+		}
+	}
+	b, err := json.Marshal(policies)
+	if err != nil {
+		return diag.WrapError(err)
+	}
+	return diag.WrapError(resource.Set(c.Name, b))
+}
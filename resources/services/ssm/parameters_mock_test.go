@@ -0,0 +1,30 @@
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/cloudquery/cq-provider-aws/client"
+	"github.com/cloudquery/cq-provider-aws/client/mocks"
+	"github.com/cloudquery/faker/v3"
+	"github.com/golang/mock/gomock"
+)
+
+func buildSSMParameters(t *testing.T, ctrl *gomock.Controller) client.Services {
+	mock := mocks.NewMockSSMClient(ctrl)
+
+	var p types.ParameterMetadata
+	if err := faker.FakeData(&p); err != nil {
+		t.Fatal(err)
+	}
+	mock.EXPECT().DescribeParameters(gomock.Any(), &ssm.DescribeParametersInput{}, gomock.Any()).Return(
+		&ssm.DescribeParametersOutput{Parameters: []types.ParameterMetadata{p}},
+		nil,
+	)
+	return client.Services{SSM: mock}
+}
+
+func TestSSMParameters(t *testing.T) {
+	client.AwsMockTestHelper(t, SsmParameters(), buildSSMParameters, client.TestOptions{})
+}
@@ -16,7 +16,7 @@ func WafRuleGroups() *schema.Table {
 		Name:         "aws_waf_rule_groups",
 		Description:  "This is AWS WAF Classic documentation",
 		Resolver:     fetchWafRuleGroups,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("waf"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
@@ -16,7 +16,7 @@ func WafRules() *schema.Table {
 		Name:         "aws_waf_rules",
 		Description:  "This is AWS WAF Classic documentation",
 		Resolver:     fetchWafRules,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("waf"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},
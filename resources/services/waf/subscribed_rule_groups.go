@@ -15,7 +15,7 @@ func WafSubscribedRuleGroups() *schema.Table {
 		Name:          "aws_waf_subscribed_rule_groups",
 		Description:   "This is AWS WAF Classic documentation",
 		Resolver:      fetchWafSubscribedRuleGroups,
-		Multiplex:     client.AccountMultiplex,
+		Multiplex:     client.ServiceAccountMultiplexer("waf"),
 		IgnoreError:   client.IgnoreCommonErrors,
 		DeleteFilter:  client.DeleteAccountFilter,
 		IgnoreInTests: true,
@@ -22,7 +22,7 @@ func WafWebAcls() *schema.Table {
 		Name:         "aws_waf_web_acls",
 		Description:  "This is AWS WAF Classic documentation",
 		Resolver:     fetchWafWebAcls,
-		Multiplex:    client.AccountMultiplex,
+		Multiplex:    client.ServiceAccountMultiplexer("waf"),
 		IgnoreError:  client.IgnoreCommonErrors,
 		DeleteFilter: client.DeleteAccountFilter,
 		Options:      schema.TableCreationOptions{PrimaryKeys: []string{"account_id", "id"}},